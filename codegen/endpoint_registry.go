@@ -94,6 +94,50 @@ var endpointRegistry = map[string]*additionalInfo{
 				},
 				Computed: true,
 			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "deletion_allowed",
+					Description: "If true, this transformation can be deleted. Only valid when in tokenization mode.",
+					Schema: &framework.OASSchema{
+						Type:         "boolean",
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "mapping_mode",
+					Description: "Specifies the mapping mode for stored tokenization values, either \"default\" or \"exportable\". Only valid when in tokenization mode.",
+					Schema: &framework.OASSchema{
+						Type:         "string",
+						Enum:         []interface{}{"default", "exportable"},
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "max_ttl",
+					Description: "The maximum TTL of a token, in seconds. Only valid when in tokenization mode.",
+					Schema: &framework.OASSchema{
+						Type:         "integer",
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "stores",
+					Description: "The list of tokenization stores to use for tokenization state. Only valid when in tokenization mode.",
+					Schema: &framework.OASSchema{
+						Type: "array",
+						Items: &framework.OASSchema{
+							Type: "string",
+						},
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+			},
 		},
 	},
 }
@@ -179,6 +179,12 @@ type templatableParam struct {
 	Computed    bool
 }
 
+// HasEnum returns true if the parameter only accepts a fixed set of string
+// values, which is validated in the generated schema.
+func (p templatableParam) HasEnum() bool {
+	return p.Schema.Type == "string" && len(p.Schema.Enum) > 0
+}
+
 func toTemplatableParam(param framework.OASParameter, isPathParameter bool) templatableParam {
 	ptrToParam := &param
 	if ptrToParam.Schema == nil {
@@ -210,6 +216,17 @@ type templatableEndpoint struct {
 	SupportsDelete          bool
 }
 
+// HasEnums returns true if any of the endpoint's parameters has an enum, in
+// which case the generated code uses the validation package.
+func (e *templatableEndpoint) HasEnums() bool {
+	for _, parameter := range e.Parameters {
+		if parameter.HasEnum() {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *templatableEndpoint) Validate() error {
 	if e == nil {
 		return fmt.Errorf("endpoint is nil")
@@ -9,6 +9,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	{{- if .HasEnums }}
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	{{- end }}
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/terraform-provider-vault/util"
 )
@@ -62,6 +65,9 @@ func {{ .UpperCaseDifferentiator }}Resource() *schema.Resource {
 			Sensitive:   true,
 			{{- end }}
 			Description: `{{ .Description }}`,
+			{{- if .HasEnum }}
+			ValidateFunc: validation.StringInSlice([]string{ {{- range $i, $v := .Schema.Enum }}{{ if $i }}, {{ end }}{{ printf "%q" $v }}{{ end -}} }, false),
+			{{- end }}
 			{{- if .IsPathParam }}
 			ForceNew: true,
 			{{- end}}
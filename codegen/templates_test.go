@@ -334,6 +334,42 @@ func TestToTemplatableParam(t *testing.T) {
 	}
 }
 
+func TestHasEnums(t *testing.T) {
+	testCases := []struct {
+		testName string
+		schema   *framework.OASSchema
+		expected bool
+	}{
+		{
+			testName: "string without enum",
+			schema:   &framework.OASSchema{Type: "string"},
+			expected: false,
+		},
+		{
+			testName: "string with enum",
+			schema:   &framework.OASSchema{Type: "string", Enum: []interface{}{"default", "exportable"}},
+			expected: true,
+		},
+		{
+			testName: "integer with enum",
+			schema:   &framework.OASSchema{Type: "integer", Enum: []interface{}{1, 2}},
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			param := toTemplatableParam(framework.OASParameter{Name: "param", Schema: testCase.schema}, false)
+			if actual := param.HasEnum(); actual != testCase.expected {
+				t.Fatalf("expected HasEnum to be %t, got %t", testCase.expected, actual)
+			}
+			endpoint := &templatableEndpoint{Parameters: []templatableParam{param}}
+			if actual := endpoint.HasEnums(); actual != testCase.expected {
+				t.Fatalf("expected HasEnums to be %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
 func TestParseParameters(t *testing.T) {
 	testCases := []struct {
 		testName       string
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -32,17 +33,39 @@ func NameResource() *schema.Resource {
 			Optional:    true,
 			Description: `The set of roles allowed to perform this transformation.`,
 		},
+		"deletion_allowed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: `If true, this transformation can be deleted. Only valid when in tokenization mode.`,
+		},
+		"mapping_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  `Specifies the mapping mode for stored tokenization values, either "default" or "exportable". Only valid when in tokenization mode.`,
+			ValidateFunc: validation.StringInSlice([]string{"default", "exportable"}, false),
+		},
 		"masking_character": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Description: `The character used to replace data when in masking mode`,
 		},
+		"max_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `The maximum TTL of a token, in seconds. Only valid when in tokenization mode.`,
+		},
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
 			Description: `The name of the transformation.`,
 			ForceNew:    true,
 		},
+		"stores": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: `The list of tokenization stores to use for tokenization state. Only valid when in tokenization mode.`,
+		},
 		"template": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -88,10 +111,22 @@ func createNameResource(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOkExists("allowed_roles"); ok {
 		data["allowed_roles"] = v
 	}
+	if v, ok := d.GetOkExists("deletion_allowed"); ok {
+		data["deletion_allowed"] = v
+	}
+	if v, ok := d.GetOkExists("mapping_mode"); ok {
+		data["mapping_mode"] = v
+	}
 	if v, ok := d.GetOkExists("masking_character"); ok {
 		data["masking_character"] = v
 	}
+	if v, ok := d.GetOkExists("max_ttl"); ok {
+		data["max_ttl"] = v
+	}
 	data["name"] = d.Get("name")
+	if v, ok := d.GetOkExists("stores"); ok {
+		data["stores"] = v
+	}
 	if v, ok := d.GetOkExists("template"); ok {
 		data["template"] = v
 	}
@@ -140,11 +175,31 @@ func readNameResource(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error setting state key 'allowed_roles': %s", err)
 		}
 	}
+	if val, ok := resp.Data["deletion_allowed"]; ok {
+		if err := d.Set("deletion_allowed", val); err != nil {
+			return fmt.Errorf("error setting state key 'deletion_allowed': %s", err)
+		}
+	}
+	if val, ok := resp.Data["mapping_mode"]; ok {
+		if err := d.Set("mapping_mode", val); err != nil {
+			return fmt.Errorf("error setting state key 'mapping_mode': %s", err)
+		}
+	}
 	if val, ok := resp.Data["masking_character"]; ok {
 		if err := d.Set("masking_character", val); err != nil {
 			return fmt.Errorf("error setting state key 'masking_character': %s", err)
 		}
 	}
+	if val, ok := resp.Data["max_ttl"]; ok {
+		if err := d.Set("max_ttl", val); err != nil {
+			return fmt.Errorf("error setting state key 'max_ttl': %s", err)
+		}
+	}
+	if val, ok := resp.Data["stores"]; ok {
+		if err := d.Set("stores", val); err != nil {
+			return fmt.Errorf("error setting state key 'stores': %s", err)
+		}
+	}
 	if val, ok := resp.Data["template"]; ok {
 		if err := d.Set("template", val); err != nil {
 			return fmt.Errorf("error setting state key 'template': %s", err)
@@ -177,9 +232,21 @@ func updateNameResource(d *schema.ResourceData, meta interface{}) error {
 	if raw, ok := d.GetOk("allowed_roles"); ok {
 		data["allowed_roles"] = raw
 	}
+	if raw, ok := d.GetOk("deletion_allowed"); ok {
+		data["deletion_allowed"] = raw
+	}
+	if raw, ok := d.GetOk("mapping_mode"); ok {
+		data["mapping_mode"] = raw
+	}
 	if raw, ok := d.GetOk("masking_character"); ok {
 		data["masking_character"] = raw
 	}
+	if raw, ok := d.GetOk("max_ttl"); ok {
+		data["max_ttl"] = raw
+	}
+	if raw, ok := d.GetOk("stores"); ok {
+		data["stores"] = raw
+	}
 	if raw, ok := d.GetOk("template"); ok {
 		data["template"] = raw
 	}
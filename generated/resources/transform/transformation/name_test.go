@@ -2,6 +2,7 @@ package transformation
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -101,6 +102,42 @@ func TestTransformationName(t *testing.T) {
 	})
 }
 
+func TestTransformationName_tokenization(t *testing.T) {
+	path := acctest.RandomWithPrefix("transform")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { util.TestEntPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"vault": nameTestProvider.ResourceProvider(),
+		},
+		CheckDestroy: destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tokenizationConfig(path, "default", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_transformation_name.test", "type", "tokenization"),
+					resource.TestCheckResourceAttr("vault_transform_transformation_name.test", "mapping_mode", "default"),
+					resource.TestCheckResourceAttr("vault_transform_transformation_name.test", "max_ttl", "3600"),
+					resource.TestCheckResourceAttr("vault_transform_transformation_name.test", "stores.#", "1"),
+					resource.TestCheckResourceAttr("vault_transform_transformation_name.test", "stores.0", "builtin/internal"),
+					resource.TestCheckResourceAttr("vault_transform_transformation_name.test", "deletion_allowed", "true"),
+				),
+			},
+			{
+				Config: tokenizationConfig(path, "default", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_transformation_name.test", "mapping_mode", "default"),
+					resource.TestCheckResourceAttr("vault_transform_transformation_name.test", "max_ttl", "7200"),
+				),
+			},
+			{
+				Config:      tokenizationConfig(path, "invalid", 7200),
+				ExpectError: regexp.MustCompile(`expected mapping_mode to be one of \[default exportable\]`),
+			},
+		},
+	})
+}
+
 func destroy(s *terraform.State) error {
 	client := nameTestProvider.SchemaProvider().Meta().(*api.Client)
 
@@ -136,3 +173,22 @@ resource "vault_transform_transformation_name" "test" {
 }
 `, path, name, tp, template, tweakSource, allowedRoles, maskingChar)
 }
+
+func tokenizationConfig(path, mappingMode string, maxTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "mount_transform" {
+  path = "%s"
+  type = "transform"
+}
+resource "vault_transform_transformation_name" "test" {
+  path = vault_mount.mount_transform.path
+  name = "ccn-tokenization"
+  type = "tokenization"
+  allowed_roles = ["payments"]
+  mapping_mode = "%s"
+  max_ttl = %d
+  stores = ["builtin/internal"]
+  deletion_allowed = true
+}
+`, path, mappingMode, maxTTL)
+}
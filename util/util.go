@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -31,6 +32,29 @@ func JsonDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(oldJSON, newJSON)
 }
 
+// ParseDurationSecond parses a duration string, a bare integer is
+// interpreted as a number of seconds.
+func ParseDurationSecond(v string) (time.Duration, error) {
+	if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Duration(i) * time.Second, nil
+	}
+	return time.ParseDuration(v)
+}
+
+// DurationDiffSuppress suppresses the diff between two durations that are
+// equivalent, e.g. "2700s" and "45m".
+func DurationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := ParseDurationSecond(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := ParseDurationSecond(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
+
 func ToStringArray(input []interface{}) []string {
 	output := make([]string, len(input))
 
@@ -254,3 +254,24 @@ func TestPathParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestDurationDiffSuppress(t *testing.T) {
+	tests := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{old: "45m", new: "2700s", expected: true},
+		{old: "30s", new: "30", expected: true},
+		{old: "1h", new: "60m", expected: true},
+		{old: "30s", new: "45s", expected: false},
+		{old: "30s", new: "invalid", expected: false},
+		{old: "", new: "30s", expected: false},
+	}
+
+	for _, tt := range tests {
+		if actual := DurationDiffSuppress("", tt.old, tt.new, nil); actual != tt.expected {
+			t.Errorf("DurationDiffSuppress(%q, %q) = %t, expected %t", tt.old, tt.new, actual, tt.expected)
+		}
+	}
+}
@@ -42,7 +42,8 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 	fields["token_bound_cidrs"] = &schema.Schema{
 		Type: schema.TypeSet,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:         schema.TypeString,
+			ValidateFunc: validateCIDROrIP,
 		},
 		Description: "Specifies the blocks of IP addresses which are allowed to use the generated token",
 		Optional:    true,
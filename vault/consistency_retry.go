@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	consistencyRetryWaitMin = 250 * time.Millisecond
+	consistencyRetryWaitMax = 5 * time.Second
+)
+
+// consistencyRetryTransport retries read requests that Vault rejected with a
+// 412 Precondition Failed. Performance standbys and replicated clusters return
+// those until they have caught up with the index of a preceding write, so a
+// read that immediately follows a write is retried with an exponential backoff
+// instead of failing the whole apply.
+//
+// These retries are independent of the 5xx retries done by the Vault client,
+// which are configured through the provider's max_retries.
+type consistencyRetryTransport struct {
+	transport  http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+func newConsistencyRetryTransport(transport http.RoundTripper, maxRetries int) http.RoundTripper {
+	return &consistencyRetryTransport{
+		transport:  transport,
+		maxRetries: maxRetries,
+		waitMin:    consistencyRetryWaitMin,
+		waitMax:    consistencyRetryWaitMax,
+	}
+}
+
+func (t *consistencyRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Only requests without a body can safely be sent again.
+	retryable := req.Method == http.MethodGet && (req.Body == nil || req.Body == http.NoBody)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || !retryable || resp.StatusCode != http.StatusPreconditionFailed || attempt >= t.maxRetries {
+			return resp, err
+		}
+
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+
+		wait := retryablehttp.DefaultBackoff(t.waitMin, t.waitMax, attempt, resp)
+		log.Printf("[DEBUG] Vault returned %d for %s %s, retrying in %s (%d left)",
+			resp.StatusCode, req.Method, req.URL.Path, wait, t.maxRetries-attempt)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
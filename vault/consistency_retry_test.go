@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConsistencyRetryTransport(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		failures         int
+		maxRetries       int
+		expectedStatus   int
+		expectedRequests int
+	}{
+		{
+			name:             "no-failure",
+			method:           http.MethodGet,
+			maxRetries:       2,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 1,
+		},
+		{
+			name:             "recovers",
+			method:           http.MethodGet,
+			failures:         2,
+			maxRetries:       2,
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 3,
+		},
+		{
+			name:             "exhausted",
+			method:           http.MethodGet,
+			failures:         3,
+			maxRetries:       2,
+			expectedStatus:   http.StatusPreconditionFailed,
+			expectedRequests: 3,
+		},
+		{
+			name:             "write-not-retried",
+			method:           http.MethodPut,
+			failures:         1,
+			maxRetries:       2,
+			expectedStatus:   http.StatusPreconditionFailed,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= tt.failures {
+					w.WriteHeader(http.StatusPreconditionFailed)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: &consistencyRetryTransport{
+					transport:  http.DefaultTransport,
+					maxRetries: tt.maxRetries,
+					waitMin:    time.Millisecond,
+					waitMax:    10 * time.Millisecond,
+				},
+			}
+
+			var body io.Reader
+			if tt.method != http.MethodGet {
+				body = strings.NewReader(`{"foo":"bar"}`)
+			}
+			req, err := http.NewRequest(tt.method, server.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if requests != tt.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tt.expectedRequests, requests)
+			}
+		})
+	}
+}
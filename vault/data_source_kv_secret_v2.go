@@ -0,0 +1,179 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretV2DataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretV2DataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the KV-V2 engine is mounted.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full name of the secret. For a nested secret, the name is the nested path excluding the mount and data prefix.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Version of the secret to retrieve. Defaults to the latest version.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secret is read.",
+			},
+			"data_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded secret data read from Vault.",
+				Sensitive:   true,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of strings read from Vault.",
+				Sensitive:   true,
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the secret version was created.",
+			},
+			"deletion_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Deletion time of the secret version, empty if it was not deleted.",
+			},
+			"destroyed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secret version has been destroyed.",
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Custom metadata of the secret.",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of strings of the secret version metadata read from Vault.",
+			},
+		},
+	}
+}
+
+func kvSecretV2DataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := strings.Trim(d.Get("mount").(string), "/")
+	name := strings.Trim(d.Get("name").(string), "/")
+	path := kvSecretV2Path(mount, name, "data")
+
+	var params map[string]string
+	if v, ok := d.GetOk("version"); ok {
+		params = map[string]string{
+			"version": strconv.Itoa(v.(int)),
+		}
+	}
+
+	log.Printf("[DEBUG] Reading KV-V2 secret from %s", path)
+	secret, err := kvReadRequest(client, path, params)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 secret from %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KV-V2 secret from %s", path)
+
+	var data map[string]interface{}
+	if secret != nil {
+		data, _ = secret.Data["data"].(map[string]interface{})
+	}
+	if data == nil {
+		return fmt.Errorf("no secret found at %q", path)
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+	}
+
+	d.SetId(path)
+	d.Set("path", path)
+	d.Set("data_json", string(jsonData))
+	if err := d.Set("data", flattenSecretData(data)); err != nil {
+		return fmt.Errorf("error setting data for %q: %s", path, err)
+	}
+
+	versionMetadata, _ := secret.Data["metadata"].(map[string]interface{})
+	if err := kvSecretV2DataSourceSetVersionMetadata(d, versionMetadata); err != nil {
+		return fmt.Errorf("error setting metadata for %q: %s", path, err)
+	}
+
+	// Reading the metadata endpoint requires its own capability, the custom
+	// metadata returned along with the data is used when it is not granted.
+	customMetadata, _ := versionMetadata["custom_metadata"].(map[string]interface{})
+
+	metadataPath := kvSecretV2Path(mount, name, "metadata")
+	log.Printf("[DEBUG] Reading KV-V2 metadata from %s", metadataPath)
+	metadata, err := kvReadRequest(client, metadataPath, nil)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 metadata from %s: %s", metadataPath, err)
+	}
+	if metadata != nil {
+		log.Printf("[DEBUG] Read KV-V2 metadata from %s", metadataPath)
+		customMetadata, _ = metadata.Data["custom_metadata"].(map[string]interface{})
+	} else {
+		log.Printf("[WARN] Unable to read KV-V2 metadata from %s, skipping", metadataPath)
+	}
+
+	if err := d.Set("custom_metadata", flattenSecretData(customMetadata)); err != nil {
+		return fmt.Errorf("error setting custom_metadata for %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func kvSecretV2DataSourceSetVersionMetadata(d *schema.ResourceData, metadata map[string]interface{}) error {
+	var version int
+	if v, ok := metadata["version"].(json.Number); ok {
+		i, err := v.Int64()
+		if err != nil {
+			return err
+		}
+		version = int(i)
+	}
+	destroyed, _ := metadata["destroyed"].(bool)
+	createdTime, _ := metadata["created_time"].(string)
+	deletionTime, _ := metadata["deletion_time"].(string)
+
+	d.Set("version", version)
+	d.Set("destroyed", destroyed)
+	d.Set("created_time", createdTime)
+	d.Set("deletion_time", deletionTime)
+
+	m := map[string]interface{}{}
+	for k, v := range metadata {
+		// custom_metadata is exposed on its own attribute.
+		if k == "custom_metadata" || v == nil {
+			continue
+		}
+		m[k] = v
+	}
+
+	return d.Set("metadata", flattenSecretData(m))
+}
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceKVSecretV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+	dsName := "data.vault_kv_secret_v2.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretV2_config(mount, name, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "path", mount+"/data/"+name),
+					resource.TestCheckResourceAttr(dsName, "data.foo", "bar"),
+					resource.TestCheckResourceAttr(dsName, "data.count", "3"),
+					resource.TestCheckResourceAttr(dsName, "data_json", `{"count":3,"foo":"bar"}`),
+					resource.TestCheckResourceAttr(dsName, "version", "1"),
+					resource.TestCheckResourceAttr(dsName, "destroyed", "false"),
+					resource.TestCheckResourceAttr(dsName, "deletion_time", ""),
+					resource.TestCheckResourceAttrSet(dsName, "created_time"),
+					resource.TestCheckResourceAttr(dsName, "metadata.version", "1"),
+					resource.TestCheckResourceAttr(dsName, "custom_metadata.owner", "team-a"),
+				),
+			},
+			{
+				Config: testDataSourceKVSecretV2_config(mount, name, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "data.foo", "baz"),
+					resource.TestCheckResourceAttr(dsName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretV2_config(mount, name, value string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path = "%s"
+  type = "kv-v2"
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  data_json = jsonencode({
+    foo   = "%s"
+    count = 3
+  })
+
+  custom_metadata {
+    data = {
+      owner = "team-a"
+    }
+  }
+}
+
+data "vault_kv_secret_v2" "test" {
+  mount      = vault_mount.kvv2.path
+  name       = vault_kv_secret_v2.test.name
+  depends_on = [vault_kv_secret_v2.test]
+}
+`, mount, name, value)
+}
@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretsListDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretsListDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path of the KV-V1 secrets to list, including the mount.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of all secret names. Nested paths end with a \"/\".",
+			},
+		},
+	}
+}
+
+func kvSecretsListDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("path").(string), "/")
+	if path == "" {
+		return fmt.Errorf("path must not be empty")
+	}
+
+	names, err := kvListSecrets(client, path)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+	d.Set("path", path)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names for %q: %s", path, err)
+	}
+
+	return nil
+}
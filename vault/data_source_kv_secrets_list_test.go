@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceKVSecretsList(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kv")
+	dsName := "data.vault_kv_secrets_list.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretsList_config(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "id", mount),
+					resource.TestCheckResourceAttr(dsName, "path", mount),
+					resource.TestCheckResourceAttr(dsName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dsName, "names.0", "bar"),
+					resource.TestCheckResourceAttr(dsName, "names.1", "foo/"),
+				),
+			},
+			{
+				Config:      testDataSourceKVSecretsList_missingConfig(mount),
+				ExpectError: regexp.MustCompile("no secrets found at"),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretsList_config(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kv" {
+  path = "%s"
+  type = "kv"
+}
+
+resource "vault_kv_secret" "bar" {
+  path      = "${vault_mount.kv.path}/bar"
+  data_json = jsonencode({ a = "b" })
+}
+
+resource "vault_kv_secret" "nested" {
+  path      = "${vault_mount.kv.path}/foo/baz"
+  data_json = jsonencode({ a = "b" })
+}
+
+data "vault_kv_secrets_list" "test" {
+  path       = "${vault_mount.kv.path}/"
+  depends_on = [vault_kv_secret.bar, vault_kv_secret.nested]
+}
+`, mount)
+}
+
+func testDataSourceKVSecretsList_missingConfig(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kv" {
+  path = "%s"
+  type = "kv"
+}
+
+data "vault_kv_secrets_list" "test" {
+  path = "${vault_mount.kv.path}/missing"
+}
+`, mount)
+}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretsListV2DataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretsListV2DataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the KV-V2 engine is mounted.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full named path of the secrets to list, excluding the mount and metadata prefix. Lists the root of the mount when not set.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secrets are listed.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of all secret names. Nested paths end with a \"/\".",
+			},
+		},
+	}
+}
+
+func kvSecretsListV2DataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := strings.Trim(d.Get("mount").(string), "/")
+	path := strings.TrimSuffix(kvSecretV2Path(mount, d.Get("name").(string), "metadata"), "/")
+
+	names, err := kvListSecrets(client, path)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+	d.Set("path", path)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names for %q: %s", path, err)
+	}
+
+	return nil
+}
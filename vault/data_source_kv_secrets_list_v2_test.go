@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceKVSecretsListV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	rootName := "data.vault_kv_secrets_list_v2.root"
+	nestedName := "data.vault_kv_secrets_list_v2.nested"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretsListV2_config(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rootName, "id", mount+"/metadata"),
+					resource.TestCheckResourceAttr(rootName, "names.#", "2"),
+					resource.TestCheckResourceAttr(rootName, "names.0", "bar"),
+					resource.TestCheckResourceAttr(rootName, "names.1", "foo/"),
+					resource.TestCheckResourceAttr(nestedName, "id", mount+"/metadata/foo"),
+					resource.TestCheckResourceAttr(nestedName, "path", mount+"/metadata/foo"),
+					resource.TestCheckResourceAttr(nestedName, "names.#", "1"),
+					resource.TestCheckResourceAttr(nestedName, "names.0", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretsListV2_config(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path = "%s"
+  type = "kv-v2"
+}
+
+resource "vault_kv_secret_v2" "bar" {
+  mount     = vault_mount.kvv2.path
+  name      = "bar"
+  data_json = jsonencode({ a = "b" })
+}
+
+resource "vault_kv_secret_v2" "nested" {
+  mount     = vault_mount.kvv2.path
+  name      = "foo/baz"
+  data_json = jsonencode({ a = "b" })
+}
+
+data "vault_kv_secrets_list_v2" "root" {
+  mount      = vault_mount.kvv2.path
+  depends_on = [vault_kv_secret_v2.bar, vault_kv_secret_v2.nested]
+}
+
+data "vault_kv_secrets_list_v2" "nested" {
+  mount      = vault_mount.kvv2.path
+  name       = "foo/"
+  depends_on = [vault_kv_secret_v2.bar, vault_kv_secret_v2.nested]
+}
+`, mount)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 type Policy struct {
@@ -24,9 +25,20 @@ type PolicyRule struct {
 	RequiredParameters []string
 	AllowedParameters  map[string][]string
 	DeniedParameters   map[string][]string
+	ControlGroup       *PolicyControlGroup
 }
 
-var allowedCapabilities = []string{"create", "read", "update", "delete", "list", "sudo", "deny"}
+type PolicyControlGroup struct {
+	Factors []*PolicyControlGroupFactor
+}
+
+type PolicyControlGroupFactor struct {
+	Name       string
+	GroupNames []string
+	Approvals  int
+}
+
+var allowedCapabilities = []string{"create", "read", "update", "patch", "delete", "list", "sudo", "deny"}
 
 func policyDocumentDataSource() *schema.Resource {
 	return &schema.Resource{
@@ -50,13 +62,15 @@ func policyDocumentDataSource() *schema.Resource {
 						},
 
 						"min_wrapping_ttl": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: wrappingTTLValidation,
 						},
 
 						"max_wrapping_ttl": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: wrappingTTLValidation,
 						},
 
 						"capabilities": {
@@ -117,6 +131,52 @@ func policyDocumentDataSource() *schema.Resource {
 								},
 							},
 						},
+
+						"control_group": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Control group requirements for the rule, requires Vault Enterprise.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"factor": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"identity": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"group_names": {
+																Type:     schema.TypeList,
+																Required: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+
+															"approvals": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -147,6 +207,14 @@ func policyDocumentDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 				MaxWrappingTTL: rawRule["max_wrapping_ttl"].(string),
 			}
 
+			if rule.MinWrappingTTL != "" && rule.MaxWrappingTTL != "" {
+				minTTL, _ := util.ParseDurationSecond(rule.MinWrappingTTL)
+				maxTTL, _ := util.ParseDurationSecond(rule.MaxWrappingTTL)
+				if minTTL > maxTTL {
+					return fmt.Errorf("min_wrapping_ttl %q of rule %q is greater than max_wrapping_ttl %q", rule.MinWrappingTTL, rule.Path, rule.MaxWrappingTTL)
+				}
+			}
+
 			if capabilityIntfs := rawRule["capabilities"].([]interface{}); len(capabilityIntfs) > 0 {
 				rule.Capabilities = policyDecodeConfigListOfStrings(capabilityIntfs)
 			}
@@ -171,6 +239,14 @@ func policyDocumentDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 				}
 			}
 
+			if controlGroupIntfs := rawRule["control_group"].([]interface{}); len(controlGroupIntfs) > 0 {
+				var err error
+				rule.ControlGroup, err = policyDecodeConfigControlGroup(controlGroupIntfs[0].(map[string]interface{}))
+				if err != nil {
+					return fmt.Errorf("error reading argument control_group: %s", err)
+				}
+			}
+
 			log.Printf("[DEBUG] Rule is: %#v", rule)
 
 			rules[i] = rule
@@ -200,6 +276,13 @@ func capabilityValidation(configI interface{}, k string) ([]string, []error) {
 	return nil, []error{fmt.Errorf("invalid capability: \"%s\" in: %s", configI.(string), k)}
 }
 
+func wrappingTTLValidation(configI interface{}, k string) ([]string, []error) {
+	if _, err := util.ParseDurationSecond(configI.(string)); err != nil {
+		return nil, []error{fmt.Errorf("invalid duration: \"%s\" in: %s", configI.(string), k)}
+	}
+	return nil, nil
+}
+
 func policyDecodeConfigListOfStrings(input []interface{}) []string {
 	output := make([]string, len(input))
 	for i, v := range input {
@@ -224,12 +307,49 @@ func policyDecodeConfigListOfMapsOfListToString(input []interface{}) (map[string
 	return output, nil
 }
 
+// policyDecodeConfigControlGroup decodes a control_group block, rejecting
+// duplicate factor names.
+func policyDecodeConfigControlGroup(input map[string]interface{}) (*PolicyControlGroup, error) {
+	controlGroup := &PolicyControlGroup{}
+	names := map[string]bool{}
+	for _, factorI := range input["factor"].([]interface{}) {
+		rawFactor := factorI.(map[string]interface{})
+		factor := &PolicyControlGroupFactor{
+			Name: rawFactor["name"].(string),
+		}
+
+		if names[factor.Name] {
+			return nil, fmt.Errorf("found duplicate factor: %s", factor.Name)
+		}
+		names[factor.Name] = true
+
+		if identityIntfs := rawFactor["identity"].([]interface{}); len(identityIntfs) > 0 {
+			rawIdentity := identityIntfs[0].(map[string]interface{})
+			factor.GroupNames = policyDecodeConfigListOfStrings(rawIdentity["group_names"].([]interface{}))
+			factor.Approvals = rawIdentity["approvals"].(int)
+		}
+
+		controlGroup.Factors = append(controlGroup.Factors, factor)
+	}
+	return controlGroup, nil
+}
+
+// policyRenderString renders s as a quoted HCL string, escaping the
+// characters that would otherwise end it early. Templated paths such as
+// "secret/{{identity.entity.id}}/*" are rendered as is.
+func policyRenderString(s string) string {
+	return `"` + policyStringEscaper.Replace(s) + `"`
+}
+
+var policyStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
 func policyRenderListOfStrings(items []string) string {
-	if len(items) > 0 {
-		return fmt.Sprintf(`["%s"]`, strings.Join(items, `", "`))
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = policyRenderString(item)
 	}
 
-	return "[]"
+	return fmt.Sprintf("[%s]", strings.Join(quoted, ", "))
 }
 
 func policyRenderListOfMapsOfListToString(input map[string][]string) string {
@@ -242,18 +362,34 @@ func policyRenderListOfMapsOfListToString(input map[string][]string) string {
 	sort.Strings(keys)
 
 	for _, k := range keys {
-		output = fmt.Sprintf("%s    \"%s\" = %s\n", output, k, policyRenderListOfStrings(input[k]))
+		output = fmt.Sprintf("%s    %s = %s\n", output, policyRenderString(k), policyRenderListOfStrings(input[k]))
+	}
+
+	return fmt.Sprintf("%s  }", output)
+}
+
+func policyRenderControlGroup(controlGroup *PolicyControlGroup) string {
+	output := "{\n"
+
+	for _, factor := range controlGroup.Factors {
+		output = fmt.Sprintf("%s    factor %s {\n", output, policyRenderString(factor.Name))
+		output = fmt.Sprintf("%s      identity {\n", output)
+		output = fmt.Sprintf("%s        group_names = %s\n", output, policyRenderListOfStrings(factor.GroupNames))
+		output = fmt.Sprintf("%s        approvals = %d\n", output, factor.Approvals)
+		output = fmt.Sprintf("%s      }\n", output)
+		output = fmt.Sprintf("%s    }\n", output)
 	}
 
 	return fmt.Sprintf("%s  }", output)
 }
 
 func policyRenderPolicyRule(rule *PolicyRule) string {
-	renderedRule := fmt.Sprintf("path \"%s\" {\n", rule.Path)
+	renderedRule := fmt.Sprintf("path %s {\n", policyRenderString(rule.Path))
 	renderedRule = fmt.Sprintf("%s  capabilities = %s\n", renderedRule, policyRenderListOfStrings(rule.Capabilities))
 
 	if rule.Description != "" {
-		renderedRule = fmt.Sprintf("# %s\n%s", rule.Description, renderedRule)
+		comment := "# " + strings.Replace(rule.Description, "\n", "\n# ", -1)
+		renderedRule = fmt.Sprintf("%s\n%s", comment, renderedRule)
 	}
 
 	if rule.RequiredParameters != nil {
@@ -269,11 +405,15 @@ func policyRenderPolicyRule(rule *PolicyRule) string {
 	}
 
 	if rule.MinWrappingTTL != "" {
-		renderedRule = fmt.Sprintf("%s  min_wrapping_ttl = \"%s\"\n", renderedRule, rule.MinWrappingTTL)
+		renderedRule = fmt.Sprintf("%s  min_wrapping_ttl = %s\n", renderedRule, policyRenderString(rule.MinWrappingTTL))
 	}
 
 	if rule.MaxWrappingTTL != "" {
-		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = \"%s\"\n", renderedRule, rule.MaxWrappingTTL)
+		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = %s\n", renderedRule, policyRenderString(rule.MaxWrappingTTL))
+	}
+
+	if rule.ControlGroup != nil {
+		renderedRule = fmt.Sprintf("%s  control_group = %s\n", renderedRule, policyRenderControlGroup(rule.ControlGroup))
 	}
 
 	return fmt.Sprintf("%s}\n", renderedRule)
@@ -135,3 +135,138 @@ func testDataSourcePolicyDocument_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestRenderPolicy_templatedPath(t *testing.T) {
+	policy := &Policy{
+		Rules: []*PolicyRule{
+			{
+				Path:           "secret/data/{{identity.entity.id}}/*",
+				Description:    "entity secrets\nper entity",
+				Capabilities:   []string{"read", "patch"},
+				MinWrappingTTL: "1s",
+				MaxWrappingTTL: "90",
+				AllowedParameters: map[string][]string{
+					"name": {`say "hi"`},
+				},
+			},
+		},
+	}
+
+	expected := `# entity secrets
+# per entity
+path "secret/data/{{identity.entity.id}}/*" {
+  capabilities = ["read", "patch"]
+  allowed_parameters = {
+    "name" = ["say \"hi\""]
+  }
+  min_wrapping_ttl = "1s"
+  max_wrapping_ttl = "90"
+}
+`
+
+	if actual := renderPolicy(policy); actual != expected {
+		t.Fatalf("expected policy %s, got %s", expected, actual)
+	}
+}
+
+func TestWrappingTTLValidation(t *testing.T) {
+	for _, v := range []string{"1s", "1h30m", "300"} {
+		if _, errs := wrappingTTLValidation(v, "max_wrapping_ttl"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+	for _, v := range []string{"", "1 hour", "-"} {
+		if _, errs := wrappingTTLValidation(v, "max_wrapping_ttl"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestDataSourcePolicyDocument_controlGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePolicyDocument_controlGroupConfig,
+				Check: resource.TestCheckResourceAttr(
+					"data.vault_policy_document.test", "hcl", testResultPolicyHCLDocumentControlGroup),
+			},
+		},
+	})
+}
+
+var testDataSourcePolicyDocument_controlGroupConfig = `
+data "vault_policy_document" "test" {
+  rule {
+    path         = "secret/data/prod/*"
+    capabilities = ["read"]
+
+    control_group {
+      factor {
+        name = "ops_manager"
+        identity {
+          group_names = ["managers"]
+          approvals   = 1
+        }
+      }
+
+      factor {
+        name = "security"
+        identity {
+          group_names = ["security", "auditors"]
+          approvals   = 2
+        }
+      }
+    }
+  }
+}
+`
+
+var testResultPolicyHCLDocumentControlGroup = `path "secret/data/prod/*" {
+  capabilities = ["read"]
+  control_group = {
+    factor "ops_manager" {
+      identity {
+        group_names = ["managers"]
+        approvals = 1
+      }
+    }
+    factor "security" {
+      identity {
+        group_names = ["security", "auditors"]
+        approvals = 2
+      }
+    }
+  }
+}
+`
+
+func TestRenderPolicy_controlGroup(t *testing.T) {
+	policy := &Policy{
+		Rules: []*PolicyRule{
+			{
+				Path:         "secret/data/prod/*",
+				Capabilities: []string{"read"},
+				ControlGroup: &PolicyControlGroup{
+					Factors: []*PolicyControlGroupFactor{
+						{
+							Name:       "ops_manager",
+							GroupNames: []string{"managers"},
+							Approvals:  1,
+						},
+						{
+							Name:       "security",
+							GroupNames: []string{"security", "auditors"},
+							Approvals:  2,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if actual := renderPolicy(policy); actual != testResultPolicyHCLDocumentControlGroup {
+		t.Fatalf("expected policy %s, got %s", testResultPolicyHCLDocumentControlGroup, actual)
+	}
+}
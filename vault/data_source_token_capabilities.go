@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func tokenCapabilitiesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: tokenCapabilitiesDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path to check the capabilities on.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The token to check the capabilities of. Defaults to the provider's token.",
+			},
+			"capabilities": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The capabilities of the token on the path.",
+			},
+		},
+	}
+}
+
+func tokenCapabilitiesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	var capabilities []string
+	var err error
+	accessor := "self"
+	if v, ok := d.GetOk("token"); ok {
+		token := v.(string)
+
+		log.Printf("[DEBUG] Looking up token accessor")
+		secret, err := client.Auth().Token().Lookup(token)
+		if err != nil {
+			return fmt.Errorf("error looking up token: %s", err)
+		}
+		accessor, err = secret.TokenAccessor()
+		if err != nil {
+			return fmt.Errorf("error reading token accessor: %s", err)
+		}
+
+		log.Printf("[DEBUG] Reading capabilities of token %q on path %q", accessor, path)
+		capabilities, err = client.Sys().Capabilities(token, path)
+		if err != nil {
+			return fmt.Errorf("error reading capabilities of token %q on path %q: %s", accessor, path, err)
+		}
+	} else {
+		log.Printf("[DEBUG] Reading capabilities of provider token on path %q", path)
+		capabilities, err = client.Sys().CapabilitiesSelf(path)
+		if err != nil {
+			return fmt.Errorf("error reading capabilities of provider token on path %q: %s", path, err)
+		}
+	}
+
+	d.SetId(accessor + ":" + path)
+	if err := d.Set("capabilities", capabilities); err != nil {
+		return fmt.Errorf("error setting capabilities: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceTokenCapabilities(t *testing.T) {
+	policy := acctest.RandomWithPrefix("test-policy")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTokenCapabilities_selfConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_token_capabilities.test", "id", "self:secret/foo"),
+					resource.TestCheckResourceAttr("data.vault_token_capabilities.test", "capabilities.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_token_capabilities.test", "capabilities.385153371", "root"),
+				),
+			},
+			{
+				Config: testDataSourceTokenCapabilities_tokenConfig(policy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_token_capabilities.test", "capabilities.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_token_capabilities.test", "capabilities.2555855207", "read"),
+					resource.TestCheckResourceAttr("data.vault_token_capabilities.test", "capabilities.1154021400", "list"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceTokenCapabilities_selfConfig = `
+data "vault_token_capabilities" "test" {
+  path = "secret/foo"
+}
+`
+
+func testDataSourceTokenCapabilities_tokenConfig(policy string) string {
+	return fmt.Sprintf(`
+resource "vault_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+path "secret/foo" {
+  capabilities = ["read", "list"]
+}
+EOT
+}
+
+resource "vault_token" "test" {
+  policies = [vault_policy.test.name]
+  ttl      = "60s"
+}
+
+data "vault_token_capabilities" "test" {
+  path  = "secret/foo"
+  token = vault_token.test.client_token
+}
+`, policy)
+}
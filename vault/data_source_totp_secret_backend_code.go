@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpSecretBackendCodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: totpSecretBackendCodeDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the TOTP secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The TOTP code to validate. When not set, a code is generated from the key instead.",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the supplied code is valid.",
+			},
+		},
+	}
+}
+
+func totpSecretBackendCodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	name := d.Get("name").(string)
+	path := backend + "/code/" + name
+
+	var resp *api.Secret
+	var err error
+	code, validate := d.GetOk("code")
+	if validate {
+		log.Printf("[DEBUG] Validating TOTP code with %q", path)
+		resp, err = client.Logical().Write(path, map[string]interface{}{
+			"code": code,
+		})
+	} else {
+		log.Printf("[DEBUG] Generating TOTP code from %q", path)
+		resp, err = client.Logical().Read(path)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading TOTP code from %q: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no response from Vault for TOTP code %q", path)
+	}
+
+	d.SetId(path)
+	if validate {
+		d.Set("valid", resp.Data["valid"])
+	} else {
+		d.Set("code", resp.Data["code"])
+	}
+
+	return nil
+}
@@ -57,10 +57,21 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	decryptedData, err := client.Logical().Write(backend+"/decrypt/"+key, payload)
 	if err != nil {
-		return fmt.Errorf("issue encrypting with key: %s", err)
+		return fmt.Errorf("issue decrypting with key: %s", err)
+	}
+	if decryptedData == nil {
+		return fmt.Errorf("no response from Vault when decrypting with key %q", key)
+	}
+
+	encodedPlaintext, ok := decryptedData.Data["plaintext"].(string)
+	if !ok {
+		return fmt.Errorf("no plaintext returned by Vault when decrypting with key %q", key)
 	}
 
-	plaintext, _ := base64.StdEncoding.DecodeString(decryptedData.Data["plaintext"].(string))
+	plaintext, err := base64.StdEncoding.DecodeString(encodedPlaintext)
+	if err != nil {
+		return fmt.Errorf("error decoding plaintext: %s", err)
+	}
 
 	d.SetId(base64.StdEncoding.EncodeToString([]byte(ciphertext)))
 	d.Set("plaintext", string(plaintext))
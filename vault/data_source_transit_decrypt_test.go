@@ -2,10 +2,14 @@ package vault
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
 )
 
 func TestDataSourceTransitDecrypt(t *testing.T) {
@@ -65,3 +69,76 @@ func testDataSourceTransitDecrypt_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestTransitDecryptDataSourceRead(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		expectedError *regexp.Regexp
+		plaintext     string
+	}{
+		{
+			name:      "plaintext",
+			status:    http.StatusOK,
+			body:      `{"data":{"plaintext":"Zm9v"}}`,
+			plaintext: "foo",
+		},
+		{
+			name:          "no-response",
+			status:        http.StatusNoContent,
+			expectedError: regexp.MustCompile("no response from Vault"),
+		},
+		{
+			name:          "no-plaintext",
+			status:        http.StatusOK,
+			body:          `{"data":{}}`,
+			expectedError: regexp.MustCompile("no plaintext returned by Vault"),
+		},
+		{
+			name:          "invalid-plaintext",
+			status:        http.StatusOK,
+			body:          `{"data":{"plaintext":"not base64"}}`,
+			expectedError: regexp.MustCompile("error decoding plaintext"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/transit/decrypt/test" {
+					t.Errorf("unexpected request path %q", r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d := transitDecryptDataSource().TestResourceData()
+			d.Set("backend", "transit")
+			d.Set("key", "test")
+			d.Set("ciphertext", "vault:v1:Zm9v")
+
+			err = transitDecryptDataSourceRead(d, client)
+			if tt.expectedError != nil {
+				if err == nil || !tt.expectedError.MatchString(err.Error()) {
+					t.Fatalf("expected an error matching %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.Get("plaintext").(string); got != tt.plaintext {
+				t.Fatalf("expected plaintext %q, got %q", tt.plaintext, got)
+			}
+		})
+	}
+}
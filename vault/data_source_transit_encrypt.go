@@ -26,7 +26,7 @@ func transitEncryptDataSource() *schema.Resource {
 			"plaintext": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Map of strings read from Vault.",
+				Description: "Plaintext to be encrypted.",
 				Sensitive:   true,
 			},
 			"context": {
@@ -67,10 +67,16 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
+	if encryptedData == nil {
+		return fmt.Errorf("no response from Vault when encrypting with key %q", key)
+	}
 
-	cipherText := encryptedData.Data["ciphertext"]
+	cipherText, ok := encryptedData.Data["ciphertext"].(string)
+	if !ok {
+		return fmt.Errorf("no ciphertext returned by Vault when encrypting with key %q", key)
+	}
 
-	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText.(string))))
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText)))
 	d.Set("ciphertext", cipherText)
 
 	return nil
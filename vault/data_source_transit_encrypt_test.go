@@ -2,10 +2,14 @@ package vault
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
 )
 
 func TestDataSourceTransitEncrypt(t *testing.T) {
@@ -65,3 +69,76 @@ func testDataSourceTransitEncrypt_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestTransitEncryptDataSourceRead(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		expectedError *regexp.Regexp
+		ciphertext    string
+	}{
+		{
+			name:       "ciphertext",
+			status:     http.StatusOK,
+			body:       `{"data":{"ciphertext":"vault:v1:Zm9v"}}`,
+			ciphertext: "vault:v1:Zm9v",
+		},
+		{
+			name:          "no-response",
+			status:        http.StatusNoContent,
+			expectedError: regexp.MustCompile("no response from Vault"),
+		},
+		{
+			name:          "no-ciphertext",
+			status:        http.StatusOK,
+			body:          `{"data":{}}`,
+			expectedError: regexp.MustCompile("no ciphertext returned by Vault"),
+		},
+		{
+			name:          "invalid-ciphertext",
+			status:        http.StatusOK,
+			body:          `{"data":{"ciphertext":1}}`,
+			expectedError: regexp.MustCompile("no ciphertext returned by Vault"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/transit/encrypt/test" {
+					t.Errorf("unexpected request path %q", r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d := transitEncryptDataSource().TestResourceData()
+			d.Set("backend", "transit")
+			d.Set("key", "test")
+			d.Set("plaintext", "foo")
+
+			err = transitEncryptDataSourceRead(d, client)
+			if tt.expectedError != nil {
+				if err == nil || !tt.expectedError.MatchString(err.Error()) {
+					t.Fatalf("expected an error matching %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := d.Get("ciphertext").(string); got != tt.ciphertext {
+				t.Fatalf("expected ciphertext %q, got %q", tt.ciphertext, got)
+			}
+		})
+	}
+}
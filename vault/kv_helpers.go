@@ -1,8 +1,10 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"path"
 	"strings"
 
@@ -136,6 +138,35 @@ func isKVv2(path string, client *api.Client) (string, bool, error) {
 	return mountPath, version == 2, nil
 }
 
+// kvCurrentVersion returns the current version of the KV v2 secret whose
+// metadata is at path, 0 if the secret doesn't exist.
+func kvCurrentVersion(client *api.Client, path string) (int, error) {
+	secret, err := kvReadRequest(client, path, nil)
+	if err != nil {
+		return 0, err
+	}
+	if secret == nil {
+		return 0, nil
+	}
+
+	v, ok := secret.Data["current_version"].(json.Number)
+	if !ok {
+		return 0, nil
+	}
+	version, err := v.Int64()
+	if err != nil {
+		return 0, fmt.Errorf("invalid current_version %q: %s", v, err)
+	}
+
+	return int(version), nil
+}
+
+// isCASRequiredError returns true if err was returned by Vault for a KV v2
+// write missing the cas option on a mount or secret that requires it.
+func isCASRequiredError(err error) bool {
+	return strings.Contains(err.Error(), "check-and-set parameter required")
+}
+
 func addPrefixToVKVPath(p, mountPath, apiPrefix string) string {
 	switch {
 	case p == mountPath, p == strings.TrimSuffix(mountPath, "/"):
@@ -145,3 +176,51 @@ func addPrefixToVKVPath(p, mountPath, apiPrefix string) string {
 		return path.Join(mountPath, apiPrefix, p)
 	}
 }
+
+// flattenSecretData converts the secret data into a map of strings. String
+// values are taken as is, everything else is written as its JSON
+// serialization so that complex types can be passed around and processed
+// elsewhere if desired.
+func flattenSecretData(data map[string]interface{}) map[string]string {
+	dataMap := map[string]string{}
+	for k, v := range data {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+		} else {
+			// Ignoring error because we know this value
+			// came from JSON in the first place and so must be valid.
+			vBytes, _ := json.Marshal(v)
+			dataMap[k] = string(vBytes)
+		}
+	}
+	return dataMap
+}
+
+// kvListSecrets returns the keys listed under path. Keys of nested
+// directories are returned with a trailing "/". An error is returned when
+// nothing exists under path, Vault doesn't distinguish a missing path from
+// an empty one.
+func kvListSecrets(client *api.Client, path string) ([]string, error) {
+	log.Printf("[DEBUG] Listing secrets at %s", path)
+	secret, err := client.Logical().List(path)
+	if err != nil {
+		return nil, fmt.Errorf("error listing secrets at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Listed secrets at %s", path)
+
+	if secret == nil || secret.Data["keys"] == nil {
+		return nil, fmt.Errorf("no secrets found at %q", path)
+	}
+
+	keys, ok := secret.Data["keys"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected keys %#v listed at %q", secret.Data["keys"], path)
+	}
+
+	names := make([]string, 0, len(keys))
+	for _, k := range keys {
+		names = append(names, k.(string))
+	}
+
+	return names, nil
+}
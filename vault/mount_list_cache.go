@@ -0,0 +1,131 @@
+package vault
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+// mountListCachePaths are the listings of the auth and secret mounts, which
+// every mount based resource fetches in full on each read.
+var mountListCachePaths = map[string]bool{
+	"/v1/sys/auth":   true,
+	"/v1/sys/mounts": true,
+}
+
+// mountListInvalidatePrefixes are the paths of the writes that can change the
+// cached listings: enabling, tuning, moving and disabling mounts, as well as
+// deleting the namespaces holding them.
+var mountListInvalidatePrefixes = []string{
+	"/v1/sys/auth/",
+	"/v1/sys/mounts/",
+	"/v1/sys/remount",
+	"/v1/sys/namespaces/",
+}
+
+// mountListCacheTransport memoizes the listings of the auth and secret mounts,
+// so that refreshing many mount based resources does not list all mounts
+// once per resource. The transport is created along with the provider's
+// client, so the cache lives for the duration of a single Terraform operation.
+// Listings are cached per namespace and token, and dropped after any write
+// that could change them.
+type mountListCacheTransport struct {
+	transport http.RoundTripper
+
+	mu      sync.Mutex
+	entries map[string]*mountListCacheEntry
+}
+
+type mountListCacheEntry struct {
+	mu   sync.Mutex
+	resp *http.Response
+	body []byte
+}
+
+func newMountListCacheTransport(transport http.RoundTripper) http.RoundTripper {
+	return &mountListCacheTransport{
+		transport: transport,
+		entries:   make(map[string]*mountListCacheEntry),
+	}
+}
+
+func (t *mountListCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := t.transport.RoundTrip(req)
+		if mountListInvalidates(req.URL.Path) {
+			t.invalidate()
+		}
+		return resp, err
+	}
+
+	if !mountListCachePaths[req.URL.Path] || req.URL.RawQuery != "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	entry := t.entry(req)
+
+	// Concurrent reads of the same listing wait for the first one to complete,
+	// instead of all listing the mounts.
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.resp == nil {
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusOK {
+			return resp, err
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		entry.resp, entry.body = resp, body
+	}
+
+	resp := *entry.resp
+	resp.Header = entry.resp.Header.Clone()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(entry.body))
+	resp.Request = req
+
+	return &resp, nil
+}
+
+func (t *mountListCacheTransport) entry(req *http.Request) *mountListCacheEntry {
+	key := strings.Join([]string{
+		req.Header.Get(consts.NamespaceHeaderName),
+		req.Header.Get(consts.AuthHeaderName),
+		req.URL.Path,
+	}, "\x00")
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry, ok := t.entries[key]
+	if !ok {
+		entry = &mountListCacheEntry{}
+		t.entries[key] = entry
+	}
+	return entry
+}
+
+func (t *mountListCacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Listings in flight complete into the discarded entries.
+	t.entries = make(map[string]*mountListCacheEntry)
+}
+
+func mountListInvalidates(path string) bool {
+	for _, prefix := range mountListInvalidatePrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
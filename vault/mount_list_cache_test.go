@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+func TestMountListCacheTransport(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newMountListCacheTransport(http.DefaultTransport),
+	}
+
+	do := func(method, path, namespace string) string {
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if namespace != "" {
+			req.Header.Set(consts.NamespaceHeaderName, namespace)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	expectRequests := func(key string, expected int) {
+		t.Helper()
+		mu.Lock()
+		defer mu.Unlock()
+		if actual := requests[key]; actual != expected {
+			t.Errorf("expected %d requests to %q, got %d", expected, key, actual)
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if body := do("GET", "/v1/sys/auth", ""); body != "/v1/sys/auth" {
+				t.Errorf("unexpected cached body %q", body)
+			}
+		}()
+	}
+	wg.Wait()
+	expectRequests("GET /v1/sys/auth", 1)
+
+	do("GET", "/v1/sys/mounts", "")
+	do("GET", "/v1/sys/mounts", "")
+	expectRequests("GET /v1/sys/mounts", 1)
+
+	do("GET", "/v1/sys/auth", "ns1")
+	expectRequests("GET /v1/sys/auth", 2)
+
+	do("GET", "/v1/sys/mounts/secret/tune", "")
+	do("GET", "/v1/sys/mounts/secret/tune", "")
+	expectRequests("GET /v1/sys/mounts/secret/tune", 2)
+
+	do("PUT", "/v1/secret/foo", "")
+	do("GET", "/v1/sys/auth", "")
+	expectRequests("GET /v1/sys/auth", 2)
+
+	do("POST", "/v1/sys/auth/approle", "")
+	do("GET", "/v1/sys/auth", "")
+	do("GET", "/v1/sys/mounts", "")
+	expectRequests("GET /v1/sys/auth", 3)
+	expectRequests("GET /v1/sys/mounts", 2)
+}
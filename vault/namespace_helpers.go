@@ -0,0 +1,176 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+const (
+	// namespaceField is added to the schema of every resource and data source,
+	// it allows targeting a namespace without configuring a provider alias.
+	namespaceField = "namespace"
+
+	// namespaceIDSeparator separates the namespace from the rest of the ID of
+	// any resource that has its namespace field set.
+	namespaceIDSeparator = ":"
+)
+
+// withNamespace adds the namespace field to r, and wraps all of its functions
+// so that they operate on a client targeting that namespace. When set, the
+// namespace is prepended to the resource's ID. Resources that are imported
+// with an ID of the form <namespace>:<id> get their namespace set from it.
+func withNamespace(r *schema.Resource) *schema.Resource {
+	if _, ok := r.Schema[namespaceField]; ok {
+		panic(fmt.Sprintf("schema already contains the %q field", namespaceField))
+	}
+
+	// The registered resources are shared by every provider instance, so
+	// the wrapping is done on a copy.
+	res := *r
+	res.Schema = make(map[string]*schema.Schema, len(r.Schema)+1)
+	for k, v := range r.Schema {
+		res.Schema[k] = v
+	}
+	res.Schema[namespaceField] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     r.Create != nil,
+		Description:  "Target namespace, relative to the namespace of the provider. Available only for Vault Enterprise",
+		ValidateFunc: validateNoTrailingSlash,
+	}
+
+	res.Create = namespaceCRUDFunc(r.Create)
+	res.Read = namespaceCRUDFunc(r.Read)
+	res.Update = namespaceCRUDFunc(r.Update)
+	res.Delete = namespaceCRUDFunc(r.Delete)
+
+	if r.Exists != nil {
+		exists := r.Exists
+		res.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			ns := d.Get(namespaceField).(string)
+			if ns == "" {
+				return exists(d, meta)
+			}
+
+			client, err := namespaceClient(meta.(*api.Client), ns)
+			if err != nil {
+				return false, err
+			}
+			d.SetId(namespaceUnqualifiedID(ns, d.Id()))
+			ok, err := exists(d, client)
+			if d.Id() != "" {
+				d.SetId(namespaceQualifiedID(ns, d.Id()))
+			}
+
+			return ok, err
+		}
+	}
+
+	if r.CustomizeDiff != nil {
+		customizeDiff := r.CustomizeDiff
+		res.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			if ns := d.Get(namespaceField).(string); ns != "" {
+				client, err := namespaceClient(meta.(*api.Client), ns)
+				if err != nil {
+					return err
+				}
+				meta = client
+			}
+			return customizeDiff(d, meta)
+		}
+	}
+
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		importer := *r.Importer
+		importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			parts := strings.SplitN(d.Id(), namespaceIDSeparator, 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return state(d, meta)
+			}
+
+			ns, id := parts[0], parts[1]
+			client, err := namespaceClient(meta.(*api.Client), ns)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+
+			results, err := state(d, client)
+			if err != nil {
+				return nil, err
+			}
+			for _, result := range results {
+				if err := result.Set(namespaceField, ns); err != nil {
+					return nil, err
+				}
+				if result.Id() != "" {
+					result.SetId(namespaceQualifiedID(ns, result.Id()))
+				}
+			}
+
+			return results, nil
+		}
+		res.Importer = &importer
+	}
+
+	return &res
+}
+
+func namespaceCRUDFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		ns := d.Get(namespaceField).(string)
+		if ns == "" {
+			return f(d, meta)
+		}
+
+		client, err := namespaceClient(meta.(*api.Client), ns)
+		if err != nil {
+			return err
+		}
+
+		d.SetId(namespaceUnqualifiedID(ns, d.Id()))
+		err = f(d, client)
+		if d.Id() != "" {
+			d.SetId(namespaceQualifiedID(ns, d.Id()))
+		}
+
+		return err
+	}
+}
+
+// namespaceClient returns a copy of client targeting ns, which is relative to
+// the namespace client is configured with.
+func namespaceClient(client *api.Client, ns string) (*api.Client, error) {
+	clone, err := client.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error cloning Vault client for namespace %q: %s", ns, err)
+	}
+
+	headers := client.Headers()
+	if parent := strings.Trim(headers.Get(consts.NamespaceHeaderName), "/"); parent != "" {
+		ns = parent + "/" + strings.Trim(ns, "/")
+	}
+
+	clone.SetHeaders(headers)
+	clone.SetToken(client.Token())
+	clone.SetNamespace(ns)
+
+	return clone, nil
+}
+
+func namespaceQualifiedID(ns, id string) string {
+	return ns + namespaceIDSeparator + id
+}
+
+func namespaceUnqualifiedID(ns, id string) string {
+	return strings.TrimPrefix(id, ns+namespaceIDSeparator)
+}
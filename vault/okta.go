@@ -19,15 +19,6 @@ type oktaGroup struct {
 	Policies []string
 }
 
-func isOktaUserPresent(client *api.Client, path, username string) (bool, error) {
-	secret, err := client.Logical().Read(oktaUserEndpoint(path, username))
-	if err != nil {
-		return false, err
-	}
-
-	return secret != nil, err
-}
-
 func listOktaUsers(client *api.Client, path string) ([]string, error) {
 	secret, err := client.Logical().List(oktaUserEndpoint(path, ""))
 	if err != nil {
@@ -51,6 +42,9 @@ func readOktaUser(client *api.Client, path string, username string) (*oktaUser,
 	if err != nil {
 		return nil, err
 	}
+	if secret == nil {
+		return nil, nil
+	}
 
 	return &oktaUser{
 		Username: username,
@@ -123,6 +117,9 @@ func readOktaGroup(client *api.Client, path string, name string) (*oktaGroup, er
 	if err != nil {
 		return nil, err
 	}
+	if secret == nil {
+		return nil, nil
+	}
 
 	return &oktaGroup{
 		Name:     name,
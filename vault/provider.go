@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -64,6 +66,18 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_TOKEN", ""),
 				Description: "Token to use to authenticate to Vault.",
 			},
+			"token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_TOKEN_FILE", ""),
+				Description: "Path to a file containing the token to use to authenticate to Vault, e.g. a Vault Agent sink. The file is read again if the token expires during a run.",
+			},
+			"agent_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(api.EnvVaultAgentAddr, ""),
+				Description: "URL of a Vault Agent to send all requests through. If no token is given, the agent's auto-auth token is used.",
+			},
 			"token_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -82,6 +96,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_CAPATH", ""),
 				Description: "Path to directory containing CA certificate files to validate the server's certificate.",
 			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(api.EnvVaultTLSServerName, ""),
+				Description: "Name to use as the SNI host when connecting via TLS.",
+			},
 			"auth_login": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -110,6 +130,10 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"auth_login_approle":    authLoginApproleSchema(),
+			"auth_login_kubernetes": authLoginKubernetesSchema(),
+			"auth_login_jwt":        authLoginJWTSchema(),
+			"auth_login_userpass":   authLoginUserpassSchema(),
 			"client_auth": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -156,6 +180,27 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES", 2),
 				Description: "Maximum number of retries when a 5xx error code is encountered.",
 			},
+			"max_retries_ccc": {
+				Type:     schema.TypeInt,
+				Optional: true,
+
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES_CCC", 10),
+				Description: "Maximum number of retries for reads failing with a 412 error code, which replicated clusters return while they are catching up with a preceding write.",
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("VAULT_RETRY_WAIT_MIN", "1s"),
+				ValidateFunc: validateDuration,
+				Description:  "Minimum time to wait before retrying a request that failed with a 5xx error code.",
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("VAULT_RETRY_WAIT_MAX", "1.5s"),
+				ValidateFunc: validateDuration,
+				Description:  "Maximum time to wait before retrying a request that failed with a 5xx error code.",
+			},
 			"namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -252,6 +297,18 @@ var (
 			Resource:      genericSecretDataSource(),
 			PathInventory: []string{"/secret/data/{path}"},
 		},
+		"vault_kv_secret_v2": {
+			Resource:      kvSecretV2DataSource(),
+			PathInventory: []string{"/secret/data/{path}", "/secret/metadata/{path}"},
+		},
+		"vault_kv_secrets_list": {
+			Resource:      kvSecretsListDataSource(),
+			PathInventory: []string{"/secret/{path}"},
+		},
+		"vault_kv_secrets_list_v2": {
+			Resource:      kvSecretsListV2DataSource(),
+			PathInventory: []string{"/secret/metadata/{path}"},
+		},
 		"vault_policy_document": {
 			Resource:      policyDocumentDataSource(),
 			PathInventory: []string{"/sys/policy/{name}"},
@@ -268,10 +325,18 @@ var (
 			Resource:      transitDecryptDataSource(),
 			PathInventory: []string{"/transit/decrypt/{name}"},
 		},
+		"vault_totp_secret_backend_code": {
+			Resource:      totpSecretBackendCodeDataSource(),
+			PathInventory: []string{"/totp/code/{name}"},
+		},
 		"vault_gcp_auth_backend_role": {
 			Resource:      gcpAuthBackendRoleDataSource(),
 			PathInventory: []string{"/auth/gcp/role/{role_name}"},
 		},
+		"vault_token_capabilities": {
+			Resource:      tokenCapabilitiesDataSource(),
+			PathInventory: []string{"/sys/capabilities", "/sys/capabilities-self"},
+		},
 	}
 
 	ResourceRegistry = map[string]*Description{
@@ -399,6 +464,10 @@ var (
 			Resource:      databaseSecretBackendStaticRoleResource(),
 			PathInventory: []string{"/database/static-roles/{name}"},
 		},
+		"vault_database_secrets_mount": {
+			Resource:      databaseSecretsMountResource(),
+			PathInventory: []string{"/database/config/{name}"},
+		},
 		"vault_github_auth_backend": {
 			Resource:      githubAuthBackendResource(),
 			PathInventory: []string{"/auth/github/config"},
@@ -423,10 +492,18 @@ var (
 			Resource:      gcpSecretBackendResource(),
 			PathInventory: []string{"/gcp/config"},
 		},
+		"vault_gcp_secret_backend_impersonated_account": {
+			Resource:      gcpSecretImpersonatedAccountResource(),
+			PathInventory: []string{"/gcp/impersonated-account/{name}"},
+		},
 		"vault_gcp_secret_roleset": {
 			Resource:      gcpSecretRolesetResource(),
 			PathInventory: []string{"/gcp/roleset/{name}"},
 		},
+		"vault_gcp_secret_static_account": {
+			Resource:      gcpSecretStaticAccountResource(),
+			PathInventory: []string{"/gcp/static-account/{name}"},
+		},
 		"vault_cert_auth_backend_role": {
 			Resource:      certAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/cert/certs/{name}"},
@@ -447,6 +524,18 @@ var (
 			Resource:      jwtAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/jwt/role/{name}"},
 		},
+		"vault_kmip_secret_backend": {
+			Resource:      kmipSecretBackendResource(),
+			PathInventory: []string{"/kmip/config"},
+		},
+		"vault_kmip_secret_backend_scope": {
+			Resource:      kmipSecretBackendScopeResource(),
+			PathInventory: []string{"/kmip/scope/{scope}"},
+		},
+		"vault_kmip_secret_backend_role": {
+			Resource:      kmipSecretBackendRoleResource(),
+			PathInventory: []string{"/kmip/scope/{scope}/role/{role}"},
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigResource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -467,6 +556,18 @@ var (
 			Resource:      oktaAuthBackendGroupResource(),
 			PathInventory: []string{"/auth/okta/groups/{name}"},
 		},
+		"vault_kv_secret": {
+			Resource:      kvSecretResource(),
+			PathInventory: []string{"/secret/{path}"},
+		},
+		"vault_kv_secret_backend_v2": {
+			Resource:      kvSecretBackendV2Resource(),
+			PathInventory: []string{"/secret/config"},
+		},
+		"vault_kv_secret_v2": {
+			Resource:      kvSecretV2Resource(),
+			PathInventory: []string{"/secret/data/{path}", "/secret/metadata/{path}"},
+		},
 		"vault_ldap_auth_backend": {
 			Resource:      ldapAuthBackendResource(),
 			PathInventory: []string{"/auth/ldap/config"},
@@ -491,6 +592,14 @@ var (
 			Resource:      nomadSecretBackendRoleResource(),
 			PathInventory: []string{"/nomad/role/{role}"},
 		},
+		"vault_plugin": {
+			Resource:      pluginResource(),
+			PathInventory: []string{"/sys/plugins/catalog/{type}/{name}"},
+		},
+		"vault_plugin_pinned_version": {
+			Resource:      pluginPinnedVersionResource(),
+			PathInventory: []string{"/sys/plugins/pins/{type}/{name}"},
+		},
 		"vault_policy": {
 			Resource:      policyResource(),
 			PathInventory: []string{"/sys/policy/{name}"},
@@ -505,11 +614,25 @@ var (
 			PathInventory:  []string{"/sys/policies/rgp/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_saml_auth_backend": {
+			Resource:       samlAuthBackendResource(),
+			PathInventory:  []string{"/auth/saml/config"},
+			EnterpriseOnly: true,
+		},
+		"vault_saml_auth_backend_role": {
+			Resource:       samlAuthBackendRoleResource(),
+			PathInventory:  []string{"/auth/saml/role/{name}"},
+			EnterpriseOnly: true,
+		},
 		"vault_mfa_duo": {
 			Resource:       mfaDuoResource(),
 			PathInventory:  []string{"/sys/mfa/method/duo/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_mfa_login_enforcement": {
+			Resource:      mfaLoginEnforcementResource(),
+			PathInventory: []string{"/identity/mfa/login-enforcement/{name}"},
+		},
 		"vault_mount": {
 			Resource:      MountResource(),
 			PathInventory: []string{"/sys/mounts/{path}"},
@@ -555,10 +678,18 @@ var (
 			Resource:      identityGroupMemberEntityIdsResource(),
 			PathInventory: []string{"/identity/group/id/{id}"},
 		},
+		"vault_identity_group_member_group_ids": {
+			Resource:      identityGroupMemberGroupIdsResource(),
+			PathInventory: []string{"/identity/group/id/{id}"},
+		},
 		"vault_identity_group_policies": {
 			Resource:      identityGroupPoliciesResource(),
 			PathInventory: []string{"/identity/lookup/group"},
 		},
+		"vault_identity_mfa_totp": {
+			Resource:      identityMfaTotpResource(),
+			PathInventory: []string{"/identity/mfa/method/totp/{method_id}"},
+		},
 		"vault_identity_oidc": {
 			Resource:      identityOidc(),
 			PathInventory: []string{"/identity/oidc/config"},
@@ -571,6 +702,22 @@ var (
 			Resource:      identityOidcKeyAllowedClientId(),
 			PathInventory: []string{"/identity/oidc/key/{name}"},
 		},
+		"vault_identity_oidc_scope": {
+			Resource:      identityOidcScopeResource(),
+			PathInventory: []string{"/identity/oidc/scope/{name}"},
+		},
+		"vault_identity_oidc_assignment": {
+			Resource:      identityOidcAssignmentResource(),
+			PathInventory: []string{"/identity/oidc/assignment/{name}"},
+		},
+		"vault_identity_oidc_client": {
+			Resource:      identityOidcClientResource(),
+			PathInventory: []string{"/identity/oidc/client/{name}"},
+		},
+		"vault_identity_oidc_provider": {
+			Resource:      identityOidcProviderResource(),
+			PathInventory: []string{"/identity/oidc/provider/{name}"},
+		},
 		"vault_identity_oidc_role": {
 			Resource:      identityOidcRole(),
 			PathInventory: []string{"/identity/oidc/role/{name}"},
@@ -606,6 +753,10 @@ var (
 			Resource:      pkiSecretBackendConfigCAResource(),
 			PathInventory: []string{"/pki/config/ca"},
 		},
+		"vault_pki_secret_backend_config_cluster": {
+			Resource:      pkiSecretBackendConfigClusterResource(),
+			PathInventory: []string{"/pki/config/cluster"},
+		},
 		"vault_pki_secret_backend_config_urls": {
 			Resource:      pkiSecretBackendConfigUrlsResource(),
 			PathInventory: []string{"/pki/config/urls"},
@@ -618,6 +769,14 @@ var (
 			Resource:      pkiSecretBackendIntermediateSetSignedResource(),
 			PathInventory: []string{"/pki/intermediate/set-signed"},
 		},
+		"vault_pki_secret_backend_issuer": {
+			Resource:      pkiSecretBackendIssuerResource(),
+			PathInventory: []string{"/pki/issuer/{issuer_ref}"},
+		},
+		"vault_pki_secret_backend_key": {
+			Resource:      pkiSecretBackendKeyResource(),
+			PathInventory: []string{"/pki/keys/generate/{type}", "/pki/keys/import", "/pki/key/{key_ref}"},
+		},
 		"vault_pki_secret_backend_role": {
 			Resource:      pkiSecretBackendRoleResource(),
 			PathInventory: []string{"/pki/roles/{name}"},
@@ -654,6 +813,10 @@ var (
 			Resource:      terraformCloudSecretRoleResource(),
 			PathInventory: []string{"/terraform/role/{name}"},
 		},
+		"vault_totp_secret_backend_key": {
+			Resource:      totpSecretBackendKeyResource(),
+			PathInventory: []string{"/totp/keys/{name}"},
+		},
 		"vault_transit_secret_backend_key": {
 			Resource:      transitSecretBackendKeyResource(),
 			PathInventory: []string{"/transit/keys/{name}"},
@@ -670,6 +833,10 @@ func providerToken(d *schema.ResourceData) (string, error) {
 		return token, nil
 	}
 
+	if tokenFile := d.Get("token_file").(string); tokenFile != "" {
+		return readTokenFile(tokenFile)
+	}
+
 	if addAddr := d.Get("add_address_to_env").(string); addAddr == "true" {
 		if addr := d.Get("address").(string); addr != "" {
 			if current, exists := os.LookupEnv("VAULT_ADDR"); exists {
@@ -697,38 +864,118 @@ func providerToken(d *schema.ResourceData) (string, error) {
 	return strings.TrimSpace(token), nil
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	clientConfig := api.DefaultConfig()
-	addr := d.Get("address").(string)
-	if addr != "" {
-		clientConfig.Address = addr
-	}
-
+// providerTLSConfig returns the TLS configuration of the provider. Like the
+// Vault CLI, the client certificate and key are read from VAULT_CLIENT_CERT
+// and VAULT_CLIENT_KEY if there is no client_auth block.
+func providerTLSConfig(d *schema.ResourceData) (*api.TLSConfig, error) {
 	clientAuthI := d.Get("client_auth").([]interface{})
 	if len(clientAuthI) > 1 {
 		return nil, fmt.Errorf("client_auth block may appear only once")
 	}
 
-	clientAuthCert := ""
-	clientAuthKey := ""
+	clientAuthCert := os.Getenv(api.EnvVaultClientCert)
+	clientAuthKey := os.Getenv(api.EnvVaultClientKey)
 	if len(clientAuthI) == 1 {
 		clientAuth := clientAuthI[0].(map[string]interface{})
 		clientAuthCert = clientAuth["cert_file"].(string)
 		clientAuthKey = clientAuth["key_file"].(string)
 	}
 
-	err := clientConfig.ConfigureTLS(&api.TLSConfig{
-		CACert:   d.Get("ca_cert_file").(string),
-		CAPath:   d.Get("ca_cert_dir").(string),
-		Insecure: d.Get("skip_tls_verify").(bool),
+	return &api.TLSConfig{
+		CACert:        d.Get("ca_cert_file").(string),
+		CAPath:        d.Get("ca_cert_dir").(string),
+		TLSServerName: d.Get("tls_server_name").(string),
+		Insecure:      d.Get("skip_tls_verify").(bool),
 
 		ClientCert: clientAuthCert,
 		ClientKey:  clientAuthKey,
-	})
+	}, nil
+}
+
+// providerRetryBackoff returns the backoff for retried requests, waiting
+// between retry_wait_min and retry_wait_max.
+func providerRetryBackoff(d *schema.ResourceData) (retryablehttp.Backoff, error) {
+	retryWaitMin, err := time.ParseDuration(d.Get("retry_wait_min").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid retry_wait_min: %s", err)
+	}
+	retryWaitMax, err := time.ParseDuration(d.Get("retry_wait_max").(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid retry_wait_max: %s", err)
+	}
+	if retryWaitMin > retryWaitMax {
+		return nil, fmt.Errorf("retry_wait_min (%s) must not be greater than retry_wait_max (%s)", retryWaitMin, retryWaitMax)
+	}
+
+	// The Vault client passes fixed bounds to the backoff, use the configured ones instead.
+	return func(_, _ time.Duration, attemptNum int, resp *http.Response) time.Duration {
+		return retryablehttp.DefaultBackoff(retryWaitMin, retryWaitMax, attemptNum, resp)
+	}, nil
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	clientConfig := api.DefaultConfig()
+	addr := d.Get("address").(string)
+	if addr != "" {
+		clientConfig.Address = addr
+	}
+	agentAddr := d.Get("agent_address").(string)
+	if agentAddr != "" {
+		clientConfig.AgentAddress = agentAddr
+	}
+
+	tlsConfig, err := providerTLSConfig(d)
 	if err != nil {
+		return nil, err
+	}
+	if err := clientConfig.ConfigureTLS(tlsConfig); err != nil {
 		return nil, fmt.Errorf("failed to configure TLS for Vault API: %s", err)
 	}
 
+	backoff, err := providerRetryBackoff(d)
+	if err != nil {
+		return nil, err
+	}
+	clientConfig.Backoff = backoff
+
+	clientConfig.HttpClient.Transport = newConsistencyRetryTransport(clientConfig.HttpClient.Transport, d.Get("max_retries_ccc").(int))
+	clientConfig.HttpClient.Transport = newMountListCacheTransport(clientConfig.HttpClient.Transport)
+
+	login, err := providerAuthLogin(d)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenName := d.Get("token_name").(string)
+	if tokenName == "" {
+		tokenName = "terraform"
+	}
+
+	// Tokens sourced from a Vault Agent can expire during a run, the child
+	// token is then derived again from the agent's current token.
+	var refresher *tokenRefresher
+	tokenFile := d.Get("token_file").(string)
+	if d.Get("token").(string) == "" && login == nil && (tokenFile != "" || clientConfig.AgentAddress != "") {
+		transport := clientConfig.HttpClient.Transport
+		timeout := clientConfig.HttpClient.Timeout
+		refresher = &tokenRefresher{
+			newClient: func() (*api.Client, error) {
+				config := api.DefaultConfig()
+				config.Address = clientConfig.Address
+				config.AgentAddress = clientConfig.AgentAddress
+				config.HttpClient = &http.Client{
+					Transport: logging.NewTransport("Vault", transport),
+					Timeout:   timeout,
+				}
+				return api.NewClient(config)
+			},
+			tokenFile: tokenFile,
+			tokenName: tokenName,
+			ttl:       d.Get("max_lease_ttl_seconds").(int),
+		}
+		clientConfig.HttpClient.Transport = newTokenRefreshTransport(clientConfig.HttpClient.Transport, refresher.refresh)
+	}
+
 	clientConfig.HttpClient.Transport = logging.NewTransport("Vault", clientConfig.HttpClient.Transport)
 
 	client, err := api.NewClient(clientConfig)
@@ -759,47 +1006,34 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
-	// Attempt to use auth/<mount>login if 'auth_login' is provided in provider config
-	authLoginI := d.Get("auth_login").([]interface{})
-	if len(authLoginI) > 1 {
-		return "", fmt.Errorf("auth_login block may appear only once")
-	}
+	// Attempt to use auth/<mount>/login if 'auth_login' or one of the
+	// 'auth_login_<method>' blocks is provided in provider config
+	if login != nil {
+		client.SetNamespace(login.namespace)
 
-	if len(authLoginI) == 1 {
-		authLogin := authLoginI[0].(map[string]interface{})
-		authLoginPath := authLogin["path"].(string)
-		authLoginNamespace := ""
-		if authLoginNamespaceI, ok := authLogin["namespace"]; ok {
-			authLoginNamespace = authLoginNamespaceI.(string)
-			client.SetNamespace(authLoginNamespace)
-		}
-		authLoginParameters := authLogin["parameters"].(map[string]interface{})
-
-		method := authLogin["method"].(string)
-		if method == "aws" {
-			if err := signAWSLogin(authLoginParameters); err != nil {
+		if login.method == "aws" {
+			if err := signAWSLogin(login.parameters); err != nil {
 				return nil, fmt.Errorf("error signing AWS login request: %s", err)
 			}
 		}
 
-		secret, err := client.Logical().Write(authLoginPath, authLoginParameters)
+		secret, err := client.Logical().Write(login.path, login.parameters)
 		if err != nil {
 			return nil, err
 		}
+		if secret == nil || secret.Auth == nil {
+			return nil, fmt.Errorf("no token returned by login to %q", login.path)
+		}
 		token = secret.Auth.ClientToken
 	}
 	if token != "" {
 		client.SetToken(token)
 	}
-	if client.Token() == "" {
+	// The Vault Agent adds its auto-auth token to requests without one.
+	if client.Token() == "" && clientConfig.AgentAddress == "" {
 		return nil, errors.New("no vault token found")
 	}
 
-	tokenName := d.Get("token_name").(string)
-	if tokenName == "" {
-		tokenName = "terraform"
-	}
-
 	// In order to enforce our relatively-short lease TTL, we derive a
 	// temporary child token that inherits all of the policies of the
 	// token we were given but expires after max_lease_ttl_seconds.
@@ -813,29 +1047,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	// can explicitly be revoked, and this limited scope won't apply to
 	// any secrets that are *written* by Terraform to Vault.
 
-	// Set the namespace to the token's namespace only for the
-	// child token creation
-	tokenInfo, err := client.Auth().Token().LookupSelf()
+	childTokenLease, err := createChildToken(client, tokenName, d.Get("max_lease_ttl_seconds").(int))
 	if err != nil {
 		return nil, err
 	}
-	if tokenNamespaceRaw, ok := tokenInfo.Data["namespace_path"]; ok {
-		tokenNamespace := tokenNamespaceRaw.(string)
-		if tokenNamespace != "" {
-			client.SetNamespace(tokenNamespace)
-		}
-	}
-
-	renewable := false
-	childTokenLease, err := client.Auth().Token().Create(&api.TokenCreateRequest{
-		DisplayName:    tokenName,
-		TTL:            fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int)),
-		ExplicitMaxTTL: fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds").(int)),
-		Renewable:      &renewable,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create limited child token: %s", err)
-	}
 
 	childToken := childTokenLease.Auth.ClientToken
 	policies := childTokenLease.Auth.Policies
@@ -844,6 +1059,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	// Set tht token to the generated child token
 	client.SetToken(childToken)
+	if refresher != nil {
+		refresher.Lock()
+		refresher.client = client
+		refresher.headers = parsedHeaders
+		refresher.Unlock()
+	}
 
 	// Set the namespace to the requested namespace, if provided
 	namespace := d.Get("namespace").(string)
@@ -857,7 +1078,7 @@ func parse(descs map[string]*Description) (map[string]*schema.Resource, error) {
 	var errs error
 	resourceMap := make(map[string]*schema.Resource)
 	for k, desc := range descs {
-		resourceMap[k] = desc.Resource
+		resourceMap[k] = withNamespace(desc.Resource)
 		if len(desc.PathInventory) == 0 {
 			errs = multierror.Append(errs, fmt.Errorf("%q needs its paths inventoried", k))
 		}
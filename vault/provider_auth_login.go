@@ -0,0 +1,213 @@
+package vault
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// defaultKubernetesServiceAccountTokenPath is where Kubernetes mounts the
+// token of a pod's service account.
+const defaultKubernetesServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// authLogin is a login request to an auth method, built from either the
+// generic auth_login block or one of the auth_login_<method> blocks.
+type authLogin struct {
+	path       string
+	namespace  string
+	method     string
+	parameters map[string]interface{}
+}
+
+// authLoginBlocks are the provider blocks that log in to Vault, only one of
+// them may be set.
+var authLoginBlocks = []string{
+	"auth_login",
+	"auth_login_approle",
+	"auth_login_kubernetes",
+	"auth_login_jwt",
+	"auth_login_userpass",
+}
+
+// authLoginMethodSchema returns the schema of an auth_login_<method> block,
+// fields are the method specific arguments besides mount and namespace.
+func authLoginMethodSchema(name, defaultMount string, fields map[string]*schema.Schema) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"mount": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     defaultMount,
+			Description: "The path where the auth method is mounted.",
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The path to the namespace that has the mounted auth method.",
+		},
+	}
+	for k, v := range fields {
+		s[k] = v
+	}
+
+	var conflicts []string
+	for _, k := range authLoginBlocks {
+		if k != name {
+			conflicts = append(conflicts, k)
+		}
+	}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: conflicts,
+		Description:   fmt.Sprintf("Login to vault using the %s auth method mounted at auth/<mount>.", strings.TrimPrefix(name, "auth_login_")),
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func authLoginApproleSchema() *schema.Schema {
+	return authLoginMethodSchema("auth_login_approle", "approle", map[string]*schema.Schema{
+		"role_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The RoleID of the AppRole.",
+		},
+		"secret_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The SecretID of the AppRole, required unless the role has bind_secret_id disabled.",
+		},
+	})
+}
+
+func authLoginKubernetesSchema() *schema.Schema {
+	return authLoginMethodSchema("auth_login_kubernetes", "kubernetes", map[string]*schema.Schema{
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the role to log in with.",
+		},
+		"jwt": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The service account token to log in with, defaults to the token of the pod's service account.",
+		},
+	})
+}
+
+func authLoginJWTSchema() *schema.Schema {
+	return authLoginMethodSchema("auth_login_jwt", "jwt", map[string]*schema.Schema{
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the role to log in with.",
+		},
+		"jwt": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The signed JSON Web Token to log in with.",
+		},
+	})
+}
+
+func authLoginUserpassSchema() *schema.Schema {
+	return authLoginMethodSchema("auth_login_userpass", "userpass", map[string]*schema.Schema{
+		"username": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The username to log in with.",
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The password to log in with.",
+		},
+	})
+}
+
+// providerAuthLogin returns the login request configured in the provider,
+// or nil if none is configured.
+func providerAuthLogin(d *schema.ResourceData) (*authLogin, error) {
+	var logins []*authLogin
+	for _, k := range authLoginBlocks {
+		blocks := d.Get(k).([]interface{})
+		if len(blocks) > 1 {
+			return nil, fmt.Errorf("%s block may appear only once", k)
+		}
+		if len(blocks) == 0 || blocks[0] == nil {
+			continue
+		}
+
+		login, err := expandAuthLogin(k, blocks[0].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		logins = append(logins, login)
+	}
+
+	switch len(logins) {
+	case 0:
+		return nil, nil
+	case 1:
+		return logins[0], nil
+	default:
+		return nil, fmt.Errorf("only one of %s may be set", strings.Join(authLoginBlocks, ", "))
+	}
+}
+
+func expandAuthLogin(block string, m map[string]interface{}) (*authLogin, error) {
+	login := &authLogin{
+		namespace:  m["namespace"].(string),
+		parameters: map[string]interface{}{},
+	}
+
+	if block == "auth_login" {
+		login.path = m["path"].(string)
+		login.method = m["method"].(string)
+		for k, v := range m["parameters"].(map[string]interface{}) {
+			login.parameters[k] = v
+		}
+		return login, nil
+	}
+
+	mount := strings.Trim(m["mount"].(string), "/")
+	login.path = "auth/" + mount + "/login"
+
+	switch block {
+	case "auth_login_approle":
+		login.parameters["role_id"] = m["role_id"]
+		if v := m["secret_id"].(string); v != "" {
+			login.parameters["secret_id"] = v
+		}
+	case "auth_login_kubernetes":
+		jwt := m["jwt"].(string)
+		if jwt == "" {
+			b, err := ioutil.ReadFile(defaultKubernetesServiceAccountTokenPath)
+			if err != nil {
+				return nil, fmt.Errorf("error reading the service account token for auth_login_kubernetes: %s", err)
+			}
+			jwt = strings.TrimSpace(string(b))
+		}
+		login.parameters["role"] = m["role"]
+		login.parameters["jwt"] = jwt
+	case "auth_login_jwt":
+		login.parameters["role"] = m["role"]
+		login.parameters["jwt"] = m["jwt"]
+	case "auth_login_userpass":
+		login.path += "/" + m["username"].(string)
+		login.parameters["password"] = m["password"]
+	default:
+		return nil, fmt.Errorf("unsupported login block %q", block)
+	}
+
+	return login, nil
+}
@@ -0,0 +1,156 @@
+package vault
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestProviderAuthLogin(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected *authLogin
+		wantErr  bool
+	}{
+		{
+			name: "none",
+			raw:  map[string]interface{}{},
+		},
+		{
+			name: "generic",
+			raw: map[string]interface{}{
+				"auth_login": []interface{}{
+					map[string]interface{}{
+						"path":      "auth/approle/login",
+						"namespace": "ns1",
+						"parameters": map[string]interface{}{
+							"role_id": "role",
+						},
+					},
+				},
+			},
+			expected: &authLogin{
+				path:      "auth/approle/login",
+				namespace: "ns1",
+				parameters: map[string]interface{}{
+					"role_id": "role",
+				},
+			},
+		},
+		{
+			name: "approle",
+			raw: map[string]interface{}{
+				"auth_login_approle": []interface{}{
+					map[string]interface{}{
+						"role_id":   "role",
+						"secret_id": "secret",
+					},
+				},
+			},
+			expected: &authLogin{
+				path: "auth/approle/login",
+				parameters: map[string]interface{}{
+					"role_id":   "role",
+					"secret_id": "secret",
+				},
+			},
+		},
+		{
+			name: "kubernetes",
+			raw: map[string]interface{}{
+				"auth_login_kubernetes": []interface{}{
+					map[string]interface{}{
+						"mount": "k8s/",
+						"role":  "role",
+						"jwt":   "jwt",
+					},
+				},
+			},
+			expected: &authLogin{
+				path: "auth/k8s/login",
+				parameters: map[string]interface{}{
+					"role": "role",
+					"jwt":  "jwt",
+				},
+			},
+		},
+		{
+			name: "jwt",
+			raw: map[string]interface{}{
+				"auth_login_jwt": []interface{}{
+					map[string]interface{}{
+						"namespace": "ns1",
+						"role":      "role",
+						"jwt":       "jwt",
+					},
+				},
+			},
+			expected: &authLogin{
+				path:      "auth/jwt/login",
+				namespace: "ns1",
+				parameters: map[string]interface{}{
+					"role": "role",
+					"jwt":  "jwt",
+				},
+			},
+		},
+		{
+			name: "userpass",
+			raw: map[string]interface{}{
+				"auth_login_userpass": []interface{}{
+					map[string]interface{}{
+						"username": "alice",
+						"password": "secret",
+					},
+				},
+			},
+			expected: &authLogin{
+				path: "auth/userpass/login/alice",
+				parameters: map[string]interface{}{
+					"password": "secret",
+				},
+			},
+		},
+		{
+			name: "multiple",
+			raw: map[string]interface{}{
+				"auth_login_jwt": []interface{}{
+					map[string]interface{}{
+						"role": "role",
+						"jwt":  "jwt",
+					},
+				},
+				"auth_login_userpass": []interface{}{
+					map[string]interface{}{
+						"username": "alice",
+						"password": "secret",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.raw)
+
+			actual, err := providerAuthLogin(d)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected %#v, got %#v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -3,16 +3,24 @@ package vault
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/pathorcontents"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/command/config"
+	"github.com/hashicorp/vault/sdk/helper/consts"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -67,6 +75,29 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccPreCheckVaultVersion runs testAccPreCheck and skips the test if the
+// Vault server is older than minVersion.
+func testAccPreCheckVaultVersion(t *testing.T, minVersion string) {
+	testAccPreCheck(t)
+
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, err := client.Sys().SealStatus()
+	if err != nil {
+		t.Fatalf("error reading the Vault server version: %s", err)
+	}
+
+	serverVersion, err := version.NewVersion(status.Version)
+	if err != nil {
+		t.Fatalf("invalid Vault server version %q: %s", status.Version, err)
+	}
+	if serverVersion.LessThan(version.Must(version.NewVersion(minVersion))) {
+		t.Skipf("test requires Vault %s+, server is running %s", minVersion, status.Version)
+	}
+}
+
 func testJWTLocal(t *testing.T) {
 	testAccPreCheck(t)
 	if v := os.Getenv("TEST_JWT"); v == "" {
@@ -190,6 +221,204 @@ func TestAccAuthLoginProviderConfigure(t *testing.T) {
 	}
 }
 
+func TestProviderTLSConfig(t *testing.T) {
+	env := map[string]string{
+		api.EnvVaultCACert:        "/env/ca.pem",
+		api.EnvVaultCAPath:        "/env/ca",
+		api.EnvVaultClientCert:    "/env/client.pem",
+		api.EnvVaultClientKey:     "/env/client-key.pem",
+		api.EnvVaultTLSServerName: "env.example.com",
+		api.EnvVaultInsecure:      "true",
+	}
+	for k, v := range env {
+		reset, err := tempSetenv(k, v)
+		defer reset()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected *api.TLSConfig
+	}{
+		{
+			name: "env",
+			raw:  map[string]interface{}{},
+			expected: &api.TLSConfig{
+				CACert:        "/env/ca.pem",
+				CAPath:        "/env/ca",
+				ClientCert:    "/env/client.pem",
+				ClientKey:     "/env/client-key.pem",
+				TLSServerName: "env.example.com",
+				Insecure:      true,
+			},
+		},
+		{
+			name: "config",
+			raw: map[string]interface{}{
+				"ca_cert_file":    "/config/ca.pem",
+				"ca_cert_dir":     "/config/ca",
+				"tls_server_name": "config.example.com",
+				"skip_tls_verify": false,
+				"client_auth": []interface{}{
+					map[string]interface{}{
+						"cert_file": "/config/client.pem",
+						"key_file":  "/config/client-key.pem",
+					},
+				},
+			},
+			expected: &api.TLSConfig{
+				CACert:        "/config/ca.pem",
+				CAPath:        "/config/ca",
+				ClientCert:    "/config/client.pem",
+				ClientKey:     "/config/client-key.pem",
+				TLSServerName: "config.example.com",
+				Insecure:      false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.raw)
+
+			actual, err := providerTLSConfig(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected %#v, got %#v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestProviderRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name          string
+		raw           map[string]interface{}
+		expectedWaits []time.Duration
+		expectedError string
+	}{
+		{
+			name:          "default",
+			raw:           map[string]interface{}{},
+			expectedWaits: []time.Duration{time.Second, 1500 * time.Millisecond, 1500 * time.Millisecond},
+		},
+		{
+			name: "config",
+			raw: map[string]interface{}{
+				"retry_wait_min": "200ms",
+				"retry_wait_max": "1s",
+			},
+			expectedWaits: []time.Duration{200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second},
+		},
+		{
+			name: "equal",
+			raw: map[string]interface{}{
+				"retry_wait_min": "2s",
+				"retry_wait_max": "2s",
+			},
+			expectedWaits: []time.Duration{2 * time.Second, 2 * time.Second},
+		},
+		{
+			name: "min-greater-than-max",
+			raw: map[string]interface{}{
+				"retry_wait_min": "2s",
+				"retry_wait_max": "1s",
+			},
+			expectedError: "retry_wait_min (2s) must not be greater than retry_wait_max (1s)",
+		},
+		{
+			name: "invalid-min",
+			raw: map[string]interface{}{
+				"retry_wait_min": "soon",
+			},
+			expectedError: "invalid retry_wait_min",
+		},
+		{
+			name: "invalid-max",
+			raw: map[string]interface{}{
+				"retry_wait_max": "later",
+			},
+			expectedError: "invalid retry_wait_max",
+		},
+	}
+
+	for _, k := range []string{"VAULT_RETRY_WAIT_MIN", "VAULT_RETRY_WAIT_MAX"} {
+		reset, err := tempUnsetenv(k)
+		defer reset()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.raw)
+
+			backoff, err := providerRetryBackoff(d)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("expected an error containing %q, got %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for attempt, expected := range tt.expectedWaits {
+				// the bounds passed by the Vault client are ignored
+				if actual := backoff(time.Millisecond, time.Millisecond, attempt, nil); actual != expected {
+					t.Errorf("expected wait %s for attempt %d, got %s", expected, attempt, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestProviderConfigureRetryBackoff(t *testing.T) {
+	var lookups []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			lookups = append(lookups, time.Now())
+			if len(lookups) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			fmt.Fprint(w, `{"data":{}}`)
+		case "/v1/auth/token/create":
+			fmt.Fprint(w, `{"auth":{"client_token":"child"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"address":        server.URL,
+		"token":          "token",
+		"max_retries":    1,
+		"retry_wait_min": "100ms",
+		"retry_wait_max": "100ms",
+	})
+	if _, err := providerConfigure(d); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(lookups) != 2 {
+		t.Fatalf("expected the token lookup to be retried once, got %d requests", len(lookups))
+	}
+	// the Vault client waits at least a second with its default backoff
+	if wait := lookups[1].Sub(lookups[0]); wait < 100*time.Millisecond || wait >= time.Second {
+		t.Fatalf("expected the retry to wait about 100ms, waited %s", wait)
+	}
+}
+
 func TestTokenReadProviderConfigureWithHeaders(t *testing.T) {
 	rootProvider := Provider()
 
@@ -271,6 +500,112 @@ func TestAccNamespaceProviderConfigure(t *testing.T) {
 
 }
 
+func TestNamespaceClient(t *testing.T) {
+	tests := []struct {
+		name     string
+		parent   string
+		ns       string
+		expected string
+	}{
+		{
+			name:     "root",
+			ns:       "ns1",
+			expected: "ns1",
+		},
+		{
+			name:     "nested",
+			parent:   "parent/",
+			ns:       "ns1",
+			expected: "parent/ns1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := api.NewClient(api.DefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+			client.SetToken("token")
+			client.AddHeader("X-Test", "value")
+			if tt.parent != "" {
+				client.SetNamespace(tt.parent)
+			}
+
+			clone, err := namespaceClient(client, tt.ns)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			headers := clone.Headers()
+			if actual := headers.Get(consts.NamespaceHeaderName); actual != tt.expected {
+				t.Errorf("expected namespace %q, got %q", tt.expected, actual)
+			}
+			if actual := headers.Get("X-Test"); actual != "value" {
+				t.Errorf("expected header X-Test to be copied, got %q", actual)
+			}
+			if actual := clone.Token(); actual != "token" {
+				t.Errorf("expected token to be copied, got %q", actual)
+			}
+			if actual := client.Headers().Get(consts.NamespaceHeaderName); actual != tt.parent {
+				t.Errorf("expected namespace of the original client to be %q, got %q", tt.parent, actual)
+			}
+		})
+	}
+}
+
+func TestAccResourceNamespaceField(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	namespacePath := acctest.RandomWithPrefix("test-namespace")
+	policyName := acctest.RandomWithPrefix("test-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testNamespaceDestroy(namespacePath),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceNamespaceFieldConfig(namespacePath, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_policy.test", "namespace", namespacePath),
+					resource.TestCheckResourceAttr("vault_policy.test", "id", namespacePath+":"+policyName),
+					resource.TestCheckResourceAttr("vault_namespace.child", "path_fq", namespacePath+"/child"),
+				),
+			},
+			{
+				ResourceName:      "vault_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceNamespaceFieldConfig(namespacePath, policyName string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = %q
+}
+
+resource "vault_namespace" "child" {
+  namespace = "${vault_namespace.test.path}"
+  path      = "child"
+}
+
+resource "vault_policy" "test" {
+  namespace = "${vault_namespace.test.path}"
+  name      = %q
+  policy    = <<EOT
+path "secret/*" { capabilities = ["read"] }
+EOT
+}
+`, namespacePath, policyName)
+}
+
 func testResourceApproleConfig_basic() string {
 	return `
 resource "vault_auth_backend" "approle" {
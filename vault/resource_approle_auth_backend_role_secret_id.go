@@ -6,8 +6,10 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -20,6 +22,7 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 	return &schema.Resource{
 		Create: approleAuthBackendRoleSecretIDCreate,
 		Read:   approleAuthBackendRoleSecretIDRead,
+		Update: approleAuthBackendRoleSecretIDUpdate,
 		Delete: approleAuthBackendRoleSecretIDDelete,
 		Exists: approleAuthBackendRoleSecretIDExists,
 
@@ -45,7 +48,19 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 				Optional:    true,
 				Description: "List of CIDR blocks that can log in using the SecretID.",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDROrIP,
+				},
+				ForceNew: true,
+			},
+
+			"token_bound_cidrs": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of CIDR blocks that can use the tokens issued with the SecretID.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDROrIP,
 				},
 				ForceNew: true,
 			},
@@ -106,6 +121,19 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 				Computed:    true,
 				Description: "The wrapped SecretID accessor.",
 			},
+
+			"min_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Minimum remaining TTL in seconds. A SecretID, or its wrapping token, expiring sooner is removed from the state so that a new one is issued.",
+			},
+
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the SecretID, or its wrapping token when wrapped, expires at. Empty if it never expires.",
+			},
 		},
 	}
 }
@@ -136,6 +164,9 @@ func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface
 	if len(cidrs) > 0 {
 		data["cidr_list"] = strings.Join(cidrs, ",")
 	}
+	if v := util.TerraformSetToStringArray(d.Get("token_bound_cidrs")); len(v) > 0 {
+		data["token_bound_cidrs"] = strings.Join(v, ",")
+	}
 	if v, ok := d.GetOk("metadata"); ok {
 		data["metadata"] = NormalizeDataJSON(v)
 	} else {
@@ -191,17 +222,27 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 	}
 
 	// If the ID is wrapped, there is no information available other than whether
-	// the wrapping token is still valid.
+	// the wrapping token is still valid and when it expires. The wrapping token
+	// is only looked up, never unwrapped.
 	if wrapped {
-		valid, err := approleAuthBackendRoleSecretIDExists(d, meta)
+		resp, err := client.Logical().Write("auth/token/lookup-accessor", map[string]interface{}{
+			"accessor": accessor,
+		})
 		if err != nil {
-			return err
+			if util.IsExpiredTokenErr(err) {
+				log.Printf("[WARN] AppRole auth backend role SecretID %q not found, removing from state", id)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("error reading AppRole auth backend wrapped SecretID %q: %s", id, err)
 		}
-		if !valid {
+		if resp == nil {
 			log.Printf("[WARN] AppRole auth backend role SecretID %q not found, removing from state", id)
 			d.SetId("")
+			return nil
 		}
-		return nil
+
+		return approleAuthBackendRoleSecretIDSetExpiration(d, resp.Data["expire_time"])
 	}
 
 	path := approleAuthBackendRolePath(backend, role) + "/secret-id-accessor/lookup"
@@ -224,20 +265,13 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 		return nil
 	}
 
-	var cidrs []string
-	switch resp.Data["cidr_list"].(type) {
-	case string:
-		if resp.Data["cidr_list"].(string) != "" {
-			cidrs = strings.Split(resp.Data["cidr_list"].(string), ",")
-		}
-	case []interface{}:
-		v := resp.Data["cidr_list"].([]interface{})
-		cidrs = make([]string, 0, len(v))
-		for _, i := range v {
-			cidrs = append(cidrs, i.(string))
-		}
-	default:
-		return fmt.Errorf("unknown type %T for cidr_list in response for SecretID %q", resp.Data["cidr_list"], accessor)
+	cidrs, err := approleAuthBackendRoleSecretIDCIDRs(resp.Data["cidr_list"])
+	if err != nil {
+		return fmt.Errorf("error reading cidr_list in response for SecretID %q: %s", accessor, err)
+	}
+	tokenBoundCIDRs, err := approleAuthBackendRoleSecretIDCIDRs(resp.Data["token_bound_cidrs"])
+	if err != nil {
+		return fmt.Errorf("error reading token_bound_cidrs in response for SecretID %q: %s", accessor, err)
 	}
 
 	metadata, err := json.Marshal(resp.Data["metadata"])
@@ -251,9 +285,73 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return fmt.Errorf("error setting cidr_list in state: %s", err)
 	}
+	err = d.Set("token_bound_cidrs", tokenBoundCIDRs)
+	if err != nil {
+		return fmt.Errorf("error setting token_bound_cidrs in state: %s", err)
+	}
 	d.Set("metadata", string(metadata))
 	d.Set("accessor", accessor)
 
+	return approleAuthBackendRoleSecretIDSetExpiration(d, resp.Data["expiration_time"])
+}
+
+// approleAuthBackendRoleSecretIDCIDRs returns the CIDR blocks from a lookup
+// response value, which depending on the Vault version is either a comma
+// separated string or a list.
+func approleAuthBackendRoleSecretIDCIDRs(v interface{}) ([]string, error) {
+	var cidrs []string
+	switch v := v.(type) {
+	case nil:
+	case string:
+		if v != "" {
+			cidrs = strings.Split(v, ",")
+		}
+	case []interface{}:
+		cidrs = make([]string, 0, len(v))
+		for _, i := range v {
+			cidrs = append(cidrs, i.(string))
+		}
+	default:
+		return nil, fmt.Errorf("unknown type %T", v)
+	}
+	return cidrs, nil
+}
+
+func approleAuthBackendRoleSecretIDUpdate(d *schema.ResourceData, meta interface{}) error {
+	// only min_ttl can be updated in place, it is not sent to Vault
+	return approleAuthBackendRoleSecretIDRead(d, meta)
+}
+
+// approleAuthBackendRoleSecretIDSetExpiration sets expiration_time from the
+// given lookup value and removes the SecretID from the state when it expires
+// within min_ttl, so that a fresh one gets issued.
+func approleAuthBackendRoleSecretIDSetExpiration(d *schema.ResourceData, v interface{}) error {
+	id := d.Id()
+
+	expirationStr, _ := v.(string)
+	if expirationStr == "" {
+		d.Set("expiration_time", "")
+		return nil
+	}
+
+	expiration, err := time.Parse(time.RFC3339Nano, expirationStr)
+	if err != nil {
+		return fmt.Errorf("error parsing expiration time %q for AppRole auth backend role SecretID %q: %s", expirationStr, id, err)
+	}
+
+	// SecretIDs without a TTL report the zero time
+	if expiration.IsZero() {
+		d.Set("expiration_time", "")
+		return nil
+	}
+	d.Set("expiration_time", expiration.Format(time.RFC3339))
+
+	minTTL := time.Duration(d.Get("min_ttl").(int)) * time.Second
+	if minTTL > 0 && time.Until(expiration) < minTTL {
+		log.Printf("[WARN] AppRole auth backend role SecretID %q expires at %s, within min_ttl, removing from state", id, expiration.Format(time.RFC3339))
+		d.SetId("")
+	}
+
 	return nil
 }
 
@@ -49,6 +49,31 @@ func TestAccAppRoleAuthBackendRoleSecretID_wrapped(t *testing.T) {
 					resource.TestCheckResourceAttr(secretIDResource, "role_name", role),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_accessor"),
 					resource.TestCheckResourceAttrSet(secretIDResource, "wrapping_token"),
+					resource.TestCheckResourceAttrSet(secretIDResource, "expiration_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppRoleAuthBackendRoleSecretID_minTTL(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleSecretIDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleSecretIDConfig_minTTL(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(secretIDResource, "backend", backend),
+					resource.TestCheckResourceAttr(secretIDResource, "role_name", role),
+					resource.TestCheckResourceAttr(secretIDResource, "min_ttl", "60"),
+					resource.TestCheckResourceAttr(secretIDResource, "cidr_list.#", "1"),
+					resource.TestCheckResourceAttr(secretIDResource, "token_bound_cidrs.#", "1"),
+					resource.TestCheckResourceAttrSet(secretIDResource, "expiration_time"),
 				),
 			},
 		},
@@ -73,6 +98,7 @@ func TestAccAppRoleAuthBackendRoleSecretID_full(t *testing.T) {
 					resource.TestCheckResourceAttr(secretIDResource, "secret_id", secretID),
 					resource.TestCheckResourceAttrSet(secretIDResource, "accessor"),
 					resource.TestCheckResourceAttr(secretIDResource, "cidr_list.#", "2"),
+					resource.TestCheckResourceAttr(secretIDResource, "token_bound_cidrs.#", "1"),
 					resource.TestCheckResourceAttr(secretIDResource, "metadata", `{"hello":"world"}`),
 				),
 			},
@@ -117,6 +143,29 @@ resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
 }`, backend, role)
 }
 
+func testAccAppRoleAuthBackendRoleSecretIDConfig_minTTL(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend = "${vault_auth_backend.approle.path}"
+  role_name = "%s"
+  token_policies = ["default", "dev", "prod"]
+  secret_id_ttl = 3600
+}
+
+resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
+  role_name = "${vault_approle_auth_backend_role.role.role_name}"
+  backend = "${vault_auth_backend.approle.path}"
+  min_ttl = 60
+  cidr_list = ["10.148.0.0/20"]
+  token_bound_cidrs = ["10.148.0.10"]
+}`, backend, role)
+}
+
 func testAccAppRoleAuthBackendRoleSecretIDConfig_full(backend, role, secretID string) string {
 	return fmt.Sprintf(`
 resource "vault_auth_backend" "approle" {
@@ -134,6 +183,7 @@ resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
   role_name = "${vault_approle_auth_backend_role.role.role_name}"
   backend = "${vault_auth_backend.approle.path}"
   cidr_list = ["10.148.0.0/20", "10.150.0.0/20"]
+  token_bound_cidrs = ["10.148.0.0/20"]
   metadata = <<EOF
 {
   "hello": "world"
@@ -30,7 +30,7 @@ func auditResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "Type of the audit device, such as 'file'.",
+				Description: "Type of the audit device, such as 'file', 'syslog' or 'socket'.",
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -87,7 +87,7 @@ func auditWrite(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(path)
 
-	return nil
+	return auditRead(d, meta)
 }
 
 func auditDelete(d *schema.ResourceData, meta interface{}) error {
@@ -111,8 +111,6 @@ func auditRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Reading audit backends %s from Vault", path)
 
-	client.Sys().ListAudit()
-
 	audits, err := client.Sys().ListAudit()
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
@@ -128,10 +126,10 @@ func auditRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	// Local is not returned by the List operation
 	d.Set("path", path)
 	d.Set("type", audit.Type)
 	d.Set("description", audit.Description)
+	d.Set("local", audit.Local)
 	d.Set("options", audit.Options)
 
 	return nil
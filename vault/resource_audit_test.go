@@ -20,6 +20,22 @@ func TestResourceAudit(t *testing.T) {
 				Config: testResourceAudit_initialConfig(path),
 				Check:  testResourceAudit_initialCheck(path),
 			},
+			{
+				ResourceName:      "vault_audit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if err := client.Sys().DisableAudit(path); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testResourceAudit_initialConfig(path),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
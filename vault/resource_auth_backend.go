@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -44,7 +45,6 @@ func AuthBackendResource() *schema.Resource {
 
 			"description": {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Optional:    true,
 				Description: "The description of the auth backend",
 			},
@@ -54,9 +54,8 @@ func AuthBackendResource() *schema.Resource {
 				Required:      false,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"tune.0.default_lease_ttl"},
-				Deprecated:    "Use the tune configuration block to avoid forcing creation of new resource on an update",
+				Deprecated:    "Use the tune configuration block instead",
 				Description:   "Default lease duration in seconds",
 			},
 
@@ -65,22 +64,29 @@ func AuthBackendResource() *schema.Resource {
 				Required:      false,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"tune.0.max_lease_ttl"},
-				Deprecated:    "Use the tune configuration block to avoid forcing creation of new resource on an update",
+				Deprecated:    "Use the tune configuration block instead",
 				Description:   "Maximum possible lease duration in seconds",
 			},
 
 			"listing_visibility": {
 				Type:          schema.TypeString,
-				ForceNew:      true,
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"tune.0.listing_visibility"},
-				Deprecated:    "Use the tune configuration block to avoid forcing creation of new resource on an update",
+				Deprecated:    "Use the tune configuration block instead",
 				Description:   "Specifies whether to show this mount in the UI-specific listing endpoint",
 			},
 
+			"token_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"tune.0.token_type"},
+				Description:   "Specifies the type of tokens that should be returned by the mount.",
+				ValidateFunc:  validation.StringInSlice([]string{"default-service", "default-batch", "service", "batch"}, false),
+			},
+
 			"local": {
 				Type:        schema.TypeBool,
 				ForceNew:    true,
@@ -112,6 +118,7 @@ func authBackendWrite(d *schema.ResourceData, meta interface{}) error {
 			DefaultLeaseTTL:   fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
 			MaxLeaseTTL:       fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
 			ListingVisibility: d.Get("listing_visibility").(string),
+			TokenType:         d.Get("token_type").(string),
 		},
 		Local: d.Get("local").(bool),
 	}
@@ -165,8 +172,19 @@ func authBackendRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("default_lease_ttl_seconds", auth.Config.DefaultLeaseTTL)
 			d.Set("max_lease_ttl_seconds", auth.Config.MaxLeaseTTL)
 			d.Set("listing_visibility", auth.Config.ListingVisibility)
+			d.Set("token_type", auth.Config.TokenType)
 			d.Set("local", auth.Local)
 			d.Set("accessor", auth.Accessor)
+
+			if v, ok := d.GetOk("tune"); ok {
+				rawL := v.(*schema.Set).List()
+				current, _ := rawL[0].(map[string]interface{})
+				rawTune := mergeAuthMethodTune(current, flattenAuthMethodTune(&auth.Config))
+				if err := d.Set("tune", []map[string]interface{}{rawTune}); err != nil {
+					log.Printf("[ERROR] Error when setting tune config for auth %q to state: %s", path, err)
+					return err
+				}
+			}
 			return nil
 		}
 	}
@@ -190,7 +208,7 @@ func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			err := authMountTune(client, "auth/"+path, raw)
 			if err != nil {
-				return nil
+				return fmt.Errorf("error writing %s auth tune to %q: %s", backendType, path, err)
 			}
 
 			log.Printf("[INFO] Written %s auth tune to '%q'", backendType, path)
@@ -198,5 +216,42 @@ func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if !d.IsNewResource() {
+		var tune api.MountConfigInput
+		changed := false
+
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			tune.Description = &description
+			changed = true
+		}
+		if d.HasChange("default_lease_ttl_seconds") {
+			tune.DefaultLeaseTTL = fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds"))
+			changed = true
+		}
+		if d.HasChange("max_lease_ttl_seconds") {
+			tune.MaxLeaseTTL = fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds"))
+			changed = true
+		}
+		if d.HasChange("listing_visibility") {
+			tune.ListingVisibility = d.Get("listing_visibility").(string)
+			changed = true
+		}
+		if d.HasChange("token_type") {
+			tune.TokenType = d.Get("token_type").(string)
+			changed = true
+		}
+
+		if changed {
+			log.Printf("[DEBUG] Tuning auth %s in Vault", path)
+			if err := client.Sys().TuneMount("auth/"+path, tune); err != nil {
+				return fmt.Errorf("error tuning auth %s in Vault: %s", path, err)
+			}
+			for _, k := range []string{"description", "default_lease_ttl_seconds", "max_lease_ttl_seconds", "listing_visibility", "token_type"} {
+				d.SetPartial(k)
+			}
+		}
+	}
+
 	return authBackendRead(d, meta)
 }
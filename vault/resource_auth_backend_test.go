@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -274,6 +275,134 @@ resource "vault_auth_backend" "test" {
 }`, backend)
 }
 
+func TestResourceAuthTuneDrift(t *testing.T) {
+	backend := acctest.RandomWithPrefix("github")
+	resName := "vault_auth_backend.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuthTuneDrift_config(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", backend),
+					checkAuthMount(backend, auditNonHMACRequestKeys([]string{"foo", "bar"})),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					err := client.Sys().TuneMount("auth/"+backend, api.MountConfigInput{
+						AuditNonHMACRequestKeys: []string{"baz"},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testResourceAuthTuneDrift_config(backend),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testResourceAuthTuneDrift_config(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+	type = "github"
+	path = "%s"
+	tune {
+		audit_non_hmac_request_keys = ["foo", "bar"]
+	}
+}`, backend)
+}
+
+func TestResourceAuthTokenType(t *testing.T) {
+	backend := acctest.RandomWithPrefix("github")
+	resName := "vault_auth_backend.test"
+	var resAuthFirst api.AuthMount
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuthTokenType_config(backend, "default-batch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthMountExists(resName, &resAuthFirst),
+					resource.TestCheckResourceAttr(resName, "token_type", "default-batch"),
+					checkAuthMount(backend, tokenType("default-batch")),
+				),
+			},
+			{
+				Config: testResourceAuthTokenType_config(backend, "service"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resName, "accessor", &resAuthFirst.Accessor),
+					resource.TestCheckResourceAttr(resName, "token_type", "service"),
+					checkAuthMount(backend, tokenType("service")),
+				),
+			},
+		},
+	})
+}
+
+func testResourceAuthTokenType_config(backend, tokenType string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+	type       = "github"
+	path       = "%s"
+	token_type = "%s"
+}`, backend, tokenType)
+}
+
+func TestResourceAuthUpdateInPlace(t *testing.T) {
+	backend := acctest.RandomWithPrefix("github")
+	resName := "vault_auth_backend.test"
+	var resAuthFirst api.AuthMount
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuthUpdateInPlace_config(backend, "initial", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthMountExists(resName, &resAuthFirst),
+					resource.TestCheckResourceAttr(resName, "description", "initial"),
+					resource.TestCheckResourceAttr(resName, "default_lease_ttl_seconds", "3600"),
+					checkAuthMount(backend, defaultLeaseTtl(3600)),
+				),
+			},
+			{
+				Config: testResourceAuthUpdateInPlace_config(backend, "updated", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resName, "accessor", &resAuthFirst.Accessor),
+					resource.TestCheckResourceAttr(resName, "description", "updated"),
+					checkAuthMount(backend, description("updated")),
+				),
+			},
+			{
+				Config: testResourceAuthUpdateInPlace_config(backend, "updated", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resName, "accessor", &resAuthFirst.Accessor),
+					resource.TestCheckResourceAttr(resName, "default_lease_ttl_seconds", "7200"),
+					checkAuthMount(backend, defaultLeaseTtl(7200)),
+				),
+			},
+		},
+	})
+}
+
+func testResourceAuthUpdateInPlace_config(backend, description string, defaultLeaseTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+	type                      = "github"
+	path                      = "%s"
+	description               = "%s"
+	default_lease_ttl_seconds = %d
+	max_lease_ttl_seconds     = 86400
+}`, backend, description, defaultLeaseTTL)
+}
+
 func TestResourceAuthTuneTtlConflict(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Providers: testProviders,
@@ -421,3 +550,33 @@ func maxLeaseTtl(expected int) func(*api.AuthMount) error {
 		return nil
 	}
 }
+
+func tokenType(expected string) func(*api.AuthMount) error {
+	return func(auth *api.AuthMount) error {
+		actual := auth.Config.TokenType
+		if actual != expected {
+			return fmt.Errorf("unexpected auth token_type: expected %q but got %q", expected, actual)
+		}
+		return nil
+	}
+}
+
+func auditNonHMACRequestKeys(expected []string) func(*api.AuthMount) error {
+	return func(auth *api.AuthMount) error {
+		actual := auth.Config.AuditNonHMACRequestKeys
+		if !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("unexpected auth audit_non_hmac_request_keys: expected %v but got %v", expected, actual)
+		}
+		return nil
+	}
+}
+
+func description(expected string) func(*api.AuthMount) error {
+	return func(auth *api.AuthMount) error {
+		actual := auth.Description
+		if actual != expected {
+			return fmt.Errorf("unexpected auth description: expected %q but got %q", expected, actual)
+		}
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -70,6 +71,30 @@ func awsAuthBackendClientResource() *schema.Resource {
 				Optional:    true,
 				Description: "The value to require in the X-Vault-AWS-IAM-Server-ID header as part of GetCallerIdentity requests that are used in the iam auth method.",
 			},
+			"identity_token_audience": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"access_key", "secret_key"},
+				Description:   "The audience claim value for plugin identity tokens. Requires Vault 1.15+.",
+			},
+			"identity_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of generated identity tokens in seconds. Requires Vault 1.15+.",
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Role ARN to assume for plugin identity token federation. Requires Vault 1.15+.",
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+				Description:  "Number of max retries the client should use for recoverable errors. The default of -1 falls back to the AWS SDK's default behavior.",
+			},
 		},
 	}
 }
@@ -84,6 +109,7 @@ func awsAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	iamEndpoint := d.Get("iam_endpoint").(string)
 	stsEndpoint := d.Get("sts_endpoint").(string)
 	stsRegion := d.Get("sts_region").(string)
+	maxRetries := d.Get("max_retries").(int)
 
 	iamServerIDHeaderValue := d.Get("iam_server_id_header_value").(string)
 
@@ -95,6 +121,17 @@ func awsAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 		"sts_endpoint":               stsEndpoint,
 		"sts_region":                 stsRegion,
 		"iam_server_id_header_value": iamServerIDHeaderValue,
+		"max_retries":                maxRetries,
+	}
+
+	if v, ok := d.GetOk("identity_token_audience"); ok || d.HasChange("identity_token_audience") {
+		data["identity_token_audience"] = v.(string)
+	}
+	if v, ok := d.GetOk("identity_token_ttl"); ok {
+		data["identity_token_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("role_arn"); ok || d.HasChange("role_arn") {
+		data["role_arn"] = v.(string)
 	}
 
 	if d.HasChange("access_key") || d.HasChange("secret_key") {
@@ -149,6 +186,16 @@ func awsAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("sts_endpoint", secret.Data["sts_endpoint"])
 	d.Set("sts_region", secret.Data["sts_region"])
 	d.Set("iam_server_id_header_value", secret.Data["iam_server_id_header_value"])
+	if v, ok := secret.Data["max_retries"]; ok {
+		d.Set("max_retries", v)
+	}
+	for _, k := range []string{"identity_token_audience", "identity_token_ttl", "role_arn"} {
+		if v, ok := secret.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q for AWS auth backend client config %q: %s", k, d.Id(), err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
@@ -41,11 +42,24 @@ func TestAccAWSAuthBackendClient_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAWSAuthBackendClientConfig_basic(backend),
-				Check:  testAccAWSAuthBackendClientCheck_attrs(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "max_retries", "5"),
+				),
 			},
 			{
 				Config: testAccAWSAuthBackendClientConfig_updated(backend),
-				Check:  testAccAWSAuthBackendClientCheck_attrs(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "max_retries", "10"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendClientConfig_basicWithoutSecretKey(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "max_retries", "-1"),
+				),
 			},
 		},
 	})
@@ -97,6 +111,31 @@ func TestAccAWSAuthBackendClient_withoutSecretKey(t *testing.T) {
 	})
 }
 
+func TestAccAWSAuthBackendClient_wif(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	backend := acctest.RandomWithPrefix("aws")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckAWSAuthBackendClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendClientConfig_wif(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "identity_token_audience", "wif-audience"),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "identity_token_ttl", "600"),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "role_arn", "arn:aws:iam::123456789012:role/vault-wif"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAuthBackendClientStsRegionNoEndpoint(t *testing.T) {
 	backend := acctest.RandomWithPrefix("aws")
 	resource.Test(t, resource.TestCase{
@@ -194,6 +233,7 @@ resource "vault_aws_auth_backend_client" "client" {
   sts_endpoint = "http://vault.test/sts"
   sts_region = "vault-test"
   iam_server_id_header_value = "vault.test"
+  max_retries = 5
 }
 `, backend)
 }
@@ -215,6 +255,7 @@ resource "vault_aws_auth_backend_client" "client" {
   sts_endpoint = "http://updated.vault.test/sts"
   sts_region = "updated-vault-test"
   iam_server_id_header_value = "updated.vault.test"
+  max_retries = 10
 }`, backend)
 }
 
@@ -273,3 +314,19 @@ resource "vault_aws_auth_backend_client" "client" {
   iam_server_id_header_value = "vault.test"
 }`, backend)
 }
+
+func testAccAWSAuthBackendClientConfig_wif(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  path = "%s"
+  type = "aws"
+  description = "Test auth backend for AWS backend client config"
+}
+
+resource "vault_aws_auth_backend_client" "client" {
+  backend = "${vault_auth_backend.aws.path}"
+  identity_token_audience = "wif-audience"
+  identity_token_ttl = 600
+  role_arn = "arn:aws:iam::123456789012:role/vault-wif"
+}`, backend)
+}
@@ -85,6 +85,23 @@ func awsSecretBackendResource() *schema.Resource {
 				Optional:    true,
 				Description: "Specifies a custom HTTP STS endpoint to use.",
 			},
+			"identity_token_audience": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"access_key", "secret_key"},
+				Description:   "The audience claim value for plugin identity tokens. Requires Vault 1.15+.",
+			},
+			"identity_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of generated identity tokens in seconds. Requires Vault 1.15+.",
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Role ARN to assume for plugin identity token federation. Requires Vault 1.15+.",
+			},
 		},
 	}
 }
@@ -137,6 +154,7 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	if stsEndpoint != "" {
 		data["sts_endpoint"] = stsEndpoint
 	}
+	awsSecretBackendUpdateIdentityTokenFields(d, data)
 	_, err = client.Logical().Write(path+"/config/root", data)
 	if err != nil {
 		return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -154,6 +172,9 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	if stsEndpoint != "" {
 		d.SetPartial("sts_endpoint")
 	}
+	d.SetPartial("identity_token_audience")
+	d.SetPartial("identity_token_ttl")
+	d.SetPartial("role_arn")
 	d.Partial(false)
 
 	return awsSecretBackendRead(d, meta)
@@ -216,6 +237,13 @@ func awsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := resp.Data["sts_endpoint"].(string); ok {
 			d.Set("sts_endpoint", v)
 		}
+		for _, k := range []string{"identity_token_audience", "identity_token_ttl", "role_arn"} {
+			if v, ok := resp.Data[k]; ok {
+				if err := d.Set(k, v); err != nil {
+					return fmt.Errorf("error setting state key %q for AWS secret backend %q: %s", k, path, err)
+				}
+			}
+		}
 	}
 
 	d.Set("path", path)
@@ -245,7 +273,8 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
 	}
-	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") {
+	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") ||
+		d.HasChange("identity_token_audience") || d.HasChange("identity_token_ttl") || d.HasChange("role_arn") {
 		log.Printf("[DEBUG] Updating root credentials at %q", path+"/config/root")
 		data := map[string]interface{}{
 			"access_key": d.Get("access_key").(string),
@@ -263,6 +292,7 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		if stsEndpoint != "" {
 			data["sts_endpoint"] = stsEndpoint
 		}
+		awsSecretBackendUpdateIdentityTokenFields(d, data)
 		_, err := client.Logical().Write(path+"/config/root", data)
 		if err != nil {
 			return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -280,11 +310,26 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		if stsEndpoint != "" {
 			d.SetPartial("sts_endpoint")
 		}
+		d.SetPartial("identity_token_audience")
+		d.SetPartial("identity_token_ttl")
+		d.SetPartial("role_arn")
 	}
 	d.Partial(false)
 	return awsSecretBackendRead(d, meta)
 }
 
+func awsSecretBackendUpdateIdentityTokenFields(d *schema.ResourceData, data map[string]interface{}) {
+	if v, ok := d.GetOk("identity_token_audience"); ok || d.HasChange("identity_token_audience") {
+		data["identity_token_audience"] = v.(string)
+	}
+	if v, ok := d.GetOk("identity_token_ttl"); ok {
+		data["identity_token_ttl"] = v.(int)
+	}
+	if v, ok := d.GetOk("role_arn"); ok || d.HasChange("role_arn") {
+		data["role_arn"] = v.(string)
+	}
+}
+
 func awsSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
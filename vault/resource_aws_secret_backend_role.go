@@ -101,6 +101,19 @@ func awsSecretBackendRoleResource() *schema.Resource {
 				Computed:    true,
 				Description: "The max allowed TTL in seconds for STS credentials (credentials TTL are capped to max_sts_ttl). Valid only when credential_type is one of assumed_role or federation_token.",
 			},
+			"session_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Session tags to be set for assume role credentials. Valid only when credential_type is assumed_role.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "External ID to set for assume role credentials. Valid only when credential_type is assumed_role.",
+			},
 		},
 	}
 }
@@ -153,6 +166,8 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		data["iam_groups"] = iamGroups
 	}
 
+	credentialTypeChanged := !d.IsNewResource() && d.HasChange("credential_type")
+
 	defaultStsTTL, defaultStsTTLOk := d.GetOk("default_sts_ttl")
 	maxStsTTL, maxStsTTLOk := d.GetOk("max_sts_ttl")
 	if credentialType == "assumed_role" || credentialType == "federation_token" {
@@ -163,12 +178,43 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 			data["max_sts_ttl"] = strconv.Itoa(maxStsTTL.(int))
 		}
 	} else {
-		if defaultStsTTLOk {
+		// The STS TTLs are computed, so after switching away from an STS
+		// credential type the values read from the previous role are still
+		// around. Clear them in Vault unless they were explicitly changed.
+		if defaultStsTTLOk && !(credentialTypeChanged && !d.HasChange("default_sts_ttl")) {
 			return fmt.Errorf("default_sts_ttl is only valid when credential_type is assumed_role or federation_token")
 		}
-		if maxStsTTLOk {
+		if maxStsTTLOk && !(credentialTypeChanged && !d.HasChange("max_sts_ttl")) {
 			return fmt.Errorf("max_sts_ttl is only valid when credential_type is assumed_role or federation_token")
 		}
+		if credentialTypeChanged {
+			data["default_sts_ttl"] = "0"
+			data["max_sts_ttl"] = "0"
+		}
+	}
+
+	sessionTags, sessionTagsOk := d.GetOk("session_tags")
+	externalID, externalIDOk := d.GetOk("external_id")
+	if credentialType == "assumed_role" {
+		if sessionTagsOk || !d.IsNewResource() {
+			data["session_tags"] = d.Get("session_tags").(map[string]interface{})
+		}
+		if externalIDOk || !d.IsNewResource() {
+			data["external_id"] = externalID.(string)
+		}
+	} else {
+		if sessionTagsOk && len(sessionTags.(map[string]interface{})) > 0 {
+			return fmt.Errorf("session_tags is only valid when credential_type is assumed_role")
+		}
+		if externalIDOk {
+			return fmt.Errorf("external_id is only valid when credential_type is assumed_role")
+		}
+		// Vault keeps the values of a previous assumed_role role around,
+		// clear them after switching away from it.
+		if credentialTypeChanged {
+			data["session_tags"] = map[string]interface{}{}
+			data["external_id"] = ""
+		}
 	}
 
 	log.Printf("[DEBUG] Creating role %q on AWS backend %q", name, backend)
@@ -230,6 +276,12 @@ func awsSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := secret.Data["iam_groups"]; ok {
 		d.Set("iam_groups", v)
 	}
+	if v, ok := secret.Data["session_tags"]; ok {
+		d.Set("session_tags", v)
+	}
+	if v, ok := secret.Data["external_id"]; ok {
+		d.Set("external_id", v)
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -202,6 +203,85 @@ func TestAccAWSSecretBackendRole_nested(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackendRole_assumedRoleSessionTags(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := getTestAWSCreds(t)
+	resourceName := "vault_aws_secret_backend_role.test_session_tags"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendRoleConfig_sessionTags(name, backend, accessKey, secretKey, "assumed_role"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "credential_type", "assumed_role"),
+					resource.TestCheckResourceAttr(resourceName, "external_id", "123456"),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.team", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.env", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccAWSSecretBackendRoleConfig_sessionTags(name, backend, accessKey, secretKey, "iam_user"),
+				ExpectError: regexp.MustCompile("session_tags is only valid when credential_type is assumed_role"),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_sessionTagsRemoved(name, backend, accessKey, secretKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "credential_type", "iam_user"),
+					resource.TestCheckResourceAttr(resourceName, "external_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecretBackendRole_stsTTLs(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := getTestAWSCreds(t)
+	resourceName := "vault_aws_secret_backend_role.test_sts_ttls"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendRoleConfig_stsTTLs(name, backend, accessKey, secretKey, "federation_token", `
+  default_sts_ttl = 900
+  max_sts_ttl = 43200`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "credential_type", "federation_token"),
+					resource.TestCheckResourceAttr(resourceName, "default_sts_ttl", "900"),
+					resource.TestCheckResourceAttr(resourceName, "max_sts_ttl", "43200"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_stsTTLs(name, backend, accessKey, secretKey, "iam_user", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "credential_type", "iam_user"),
+					resource.TestCheckResourceAttr(resourceName, "default_sts_ttl", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_sts_ttl", "0"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_stsTTLs(name, backend, accessKey, secretKey, "iam_user", `
+  max_sts_ttl = 43200`),
+				ExpectError: regexp.MustCompile("max_sts_ttl is only valid when credential_type is assumed_role or federation_token"),
+			},
+		},
+	})
+}
+
 func testAccAWSSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -310,3 +390,59 @@ resource "vault_aws_secret_backend_role" "test_role_groups" {
 }
 `, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_updated, name, testAccAWSSecretBackendRolePolicyArn_updated, name, testAccAWSSecretBackendRolePolicyInline_updated, testAccAWSSecretBackendRolePolicyArn_updated, name, testAccAWSSecretBackendRoleRoleArn_updated, name)
 }
+
+func testAccAWSSecretBackendRoleConfig_sessionTags(name, path, accessKey, secretKey, credentialType string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "test_session_tags" {
+  name = "%s"
+  role_arns = ["%s"]
+  credential_type = "%s"
+  external_id = "123456"
+  session_tags = {
+    team = "platform"
+    env  = "test"
+  }
+  backend = "${vault_aws_secret_backend.test.path}"
+}
+`, path, accessKey, secretKey, name, testAccAWSSecretBackendRoleRoleArn_basic, credentialType)
+}
+
+func testAccAWSSecretBackendRoleConfig_sessionTagsRemoved(name, path, accessKey, secretKey string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "test_session_tags" {
+  name = "%s"
+  policy_document = %q
+  credential_type = "iam_user"
+  backend = "${vault_aws_secret_backend.test.path}"
+}
+`, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_basic)
+}
+
+func testAccAWSSecretBackendRoleConfig_stsTTLs(name, path, accessKey, secretKey, credentialType, extraConfig string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "test_sts_ttls" {
+  name = "%s"
+  policy_document = %q
+  credential_type = "%s"
+  backend = "${vault_aws_secret_backend.test.path}"%s
+}
+`, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_basic, credentialType, extraConfig)
+}
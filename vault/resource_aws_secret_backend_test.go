@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
@@ -96,6 +97,39 @@ func TestAccAWSSecretBackend_import(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackend_wif(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	path := acctest.RandomWithPrefix("tf-test-aws")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendConfig_wif(path, "wif-audience", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "identity_token_audience", "wif-audience"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "identity_token_ttl", "600"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "role_arn", "arn:aws:iam::123456789012:role/vault-wif"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "access_key", ""),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendConfig_wif(path, "wif-audience-updated", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "identity_token_audience", "wif-audience-updated"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "identity_token_ttl", "1800"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -157,3 +191,14 @@ resource "vault_aws_secret_backend" "test" {
   region = "us-west-1"
 }`, path)
 }
+
+func testAccAWSSecretBackendConfig_wif(path, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  identity_token_audience = "%s"
+  identity_token_ttl = %d
+  role_arn = "arn:aws:iam::123456789012:role/vault-wif"
+}`, path, audience, ttl)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -56,8 +57,14 @@ func azureAuthBackendConfigResource() *schema.Resource {
 				Description: "The configured URL for the application registered in Azure Active Directory.",
 			},
 			"environment": {
-				Type:        schema.TypeString,
-				Optional:    true,
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AzurePublicCloud",
+					"AzureUSGovernmentCloud",
+					"AzureChinaCloud",
+					"AzureGermanCloud",
+				}, false),
 				Description: "The Azure cloud environment. Valid values: AzurePublicCloud, AzureUSGovernmentCloud, AzureChinaCloud, AzureGermanCloud.",
 			},
 		},
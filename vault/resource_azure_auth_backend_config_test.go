@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -44,7 +45,15 @@ func TestAccAzureAuthBackendConfig_basic(t *testing.T) {
 			},
 			{
 				Config: testAccAzureAuthBackendConfig_updated(backend),
-				Check:  testAccAzureAuthBackendConfigCheck_attrs(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAzureAuthBackendConfigCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_azure_auth_backend_config.config", "environment", "AzureUSGovernmentCloud"),
+					resource.TestCheckResourceAttr("vault_azure_auth_backend_config.config", "resource", "https://management.usgovcloudapi.net/"),
+				),
+			},
+			{
+				Config:      testAccAzureAuthBackendConfig_invalidEnvironment(backend),
+				ExpectError: regexp.MustCompile(`expected environment to be one of`),
 			},
 		},
 	})
@@ -144,6 +153,23 @@ resource "vault_azure_auth_backend_config" "config" {
   tenant_id = "11111111-2222-3333-4444-555555555555"
   client_id = "11111111-2222-3333-4444-555555555555"
   client_secret = "12345678901234567890"
+  resource = "https://management.usgovcloudapi.net/"
+  environment = "AzureUSGovernmentCloud"
+}`, backend)
+}
+
+func testAccAzureAuthBackendConfig_invalidEnvironment(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "azure" {
+  path = "%s"
+  type = "azure"
+  description = "Test auth backend for Azure backend config"
+}
+
+resource "vault_azure_auth_backend_config" "config" {
+  backend = "${vault_auth_backend.azure.path}"
+  tenant_id = "11111111-2222-3333-4444-555555555555"
   resource = "http://vault.hashicorp.com"
+  environment = "AzureMoonCloud"
 }`, backend)
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -88,6 +89,33 @@ func azureSecretBackendRoleResource() *schema.Resource {
 				Optional:    true,
 				Description: "Application Object ID for an existing service principal that will be used instead of creating dynamic service principals.",
 			},
+			"sign_in_audience": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AzureADMyOrg",
+					"AzureADMultipleOrgs",
+					"AzureADandPersonalMicrosoftAccount",
+					"PersonalMicrosoftAccount",
+				}, false),
+				Description: "Specifies the security principal types that are allowed to sign in to the application.",
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "A list of Azure tags to attach to an application.",
+			},
+			"permanently_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates whether the application registration is permanently deleted when the credentials are revoked.",
+			},
 			"ttl": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -139,6 +167,18 @@ func azureSecretBackendRoleUpdateFields(d *schema.ResourceData, data map[string]
 		data["application_object_id"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("sign_in_audience"); ok {
+		data["sign_in_audience"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("tags"); ok || d.HasChange("tags") {
+		data["tags"] = v.([]interface{})
+	}
+
+	if v, ok := d.GetOkExists("permanently_delete"); ok {
+		data["permanently_delete"] = v.(bool)
+	}
+
 	if v, ok := d.GetOk("ttl"); ok {
 		data["ttl"] = v.(string)
 	}
@@ -197,6 +237,9 @@ func azureSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		"ttl",
 		"max_ttl",
 		"application_object_id",
+		"sign_in_audience",
+		"tags",
+		"permanently_delete",
 	} {
 		if v, ok := resp.Data[k]; ok {
 			if err := d.Set(k, v); err != nil {
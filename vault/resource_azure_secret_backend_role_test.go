@@ -43,6 +43,11 @@ func TestAzureSecretBackendRole(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "azure_groups.#", "1"),
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "azure_groups.2681484837.group_name", "foobar"),
 					resource.TestCheckResourceAttrSet("vault_azure_secret_backend_role.test_azure_groups", "azure_groups.2681484837.object_id"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "sign_in_audience", "AzureADMyOrg"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "tags.#", "2"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "tags.0", "team:engineering"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "tags.1", "environment:development"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "permanently_delete", "true"),
 				),
 			},
 		},
@@ -102,6 +107,10 @@ resource "vault_azure_secret_backend_role" "test_azure_groups" {
   max_ttl     = 600
   description = "Test for Vault Provider"
 
+  sign_in_audience   = "AzureADMyOrg"
+  tags               = ["team:engineering", "environment:development"]
+  permanently_delete = true
+
   azure_groups {
     group_name = "foobar"
   }
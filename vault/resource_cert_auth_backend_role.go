@@ -3,13 +3,20 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/hashicorp/vault/api"
 )
 
+var (
+	certAuthOIDRegexp               = regexp.MustCompile(`^[0-9]+(\.[0-9]+)+$`)
+	certAuthRequiredExtensionRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)+:.+$`)
+)
+
 func certAuthBackendRoleResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"name": {
@@ -67,22 +74,81 @@ func certAuthBackendRoleResource() *schema.Resource {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			Optional: true,
-			Computed: true,
+			Optional:      true,
+			Computed:      true,
+			Deprecated:    "use `allowed_organizational_units` instead",
+			ConflictsWith: []string{"allowed_organizational_units"},
+		},
+		"allowed_organizational_units": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:      true,
+			Computed:      true,
+			Description:   "Allowed organizational units for authenticated client certificates.",
+			ConflictsWith: []string{"allowed_organization_units"},
 		},
 		"required_extensions": {
 			Type: schema.TypeSet,
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
+				ValidateFunc: validation.StringMatch(certAuthRequiredExtensionRegexp,
+					"must be of the form <oid>:<value>, e.g. 1.2.3.4:foo"),
 			},
-			Optional: true,
-			Computed: true,
+			Optional:    true,
+			Computed:    true,
+			Description: "Custom extensions that must be present on client certificates, as <oid>:<value> pairs. The value supports globbing.",
+		},
+		"allowed_metadata_extensions": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringMatch(certAuthOIDRegexp,
+					"must be an OID, e.g. 1.2.3.4"),
+			},
+			Optional:    true,
+			Computed:    true,
+			Description: "OIDs of the extensions to store in the alias metadata on successful login, with the dots of the OIDs replaced by hyphens.",
 		},
 		"display_name": {
 			Type:     schema.TypeString,
 			Optional: true,
 			Computed: true,
 		},
+		"ocsp_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "If enabled, validate certificates' revocation status using OCSP.",
+		},
+		"ocsp_ca_certificates": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Any additional CA certificates needed to verify OCSP responses. Provided as base64 encoded PEM data.",
+		},
+		"ocsp_servers_override": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:    true,
+			Computed:    true,
+			Description: "A list of OCSP server addresses. If unset, the OCSP server is determined from the AuthorityInformationAccess extension on the certificate being inspected.",
+		},
+		"ocsp_fail_open": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "If true and an OCSP response cannot be fetched or is of an unknown status, the login will proceed as if the certificate has not been revoked.",
+		},
+		"ocsp_query_all_servers": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "If set to true, rather than accepting the first successful OCSP response, query all servers and consider the certificate valid only if all servers agree.",
+		},
 		"backend": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -161,6 +227,54 @@ func certCertResourcePath(backend, name string) string {
 	return "auth/" + strings.Trim(backend, "/") + "/certs/" + strings.Trim(name, "/")
 }
 
+// certAuthOCSPFields lists the OCSP fields, they are only returned by
+// Vault 1.13+.
+var certAuthOCSPFields = []string{
+	"ocsp_enabled",
+	"ocsp_ca_certificates",
+	"ocsp_servers_override",
+	"ocsp_fail_open",
+	"ocsp_query_all_servers",
+}
+
+// certAuthSetFields lists the set fields written as is to Vault.
+var certAuthSetFields = []string{
+	"allowed_names",
+	"allowed_common_names",
+	"allowed_dns_sans",
+	"allowed_email_sans",
+	"allowed_uri_sans",
+	"allowed_organizational_units",
+	"required_extensions",
+	"allowed_metadata_extensions",
+}
+
+func updateCertAuthSetFields(d *schema.ResourceData, data map[string]interface{}) {
+	for _, k := range certAuthSetFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(*schema.Set).List()
+		}
+	}
+
+	// Deprecated, Vault accepts it as an alias of allowed_organizational_units.
+	if v, ok := d.GetOk("allowed_organization_units"); ok {
+		data["allowed_organization_units"] = v.(*schema.Set).List()
+	}
+}
+
+func updateCertAuthOCSPFields(d *schema.ResourceData, data map[string]interface{}) {
+	for _, k := range certAuthOCSPFields {
+		v, ok := d.GetOkExists(k)
+		if !ok {
+			continue
+		}
+		if s, ok := v.(*schema.Set); ok {
+			v = s.List()
+		}
+		data[k] = v
+	}
+}
+
 func certAuthResourceWrite(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -174,34 +288,14 @@ func certAuthResourceWrite(d *schema.ResourceData, meta interface{}) error {
 
 	data["certificate"] = d.Get("certificate")
 
-	if v, ok := d.GetOk("allowed_names"); ok {
-		data["allowed_names"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("allowed_common_names"); ok {
-		data["allowed_common_names"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("allowed_dns_sans"); ok {
-		data["allowed_dns_sans"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("allowed_uri_sans"); ok {
-		data["allowed_uri_sans"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("allowed_organization_units"); ok {
-		data["allowed_organization_units"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("required_extensions"); ok {
-		data["required_extensions"] = v.(*schema.Set).List()
-	}
+	updateCertAuthSetFields(d, data)
 
 	if v, ok := d.GetOk("display_name"); ok {
 		data["display_name"] = v.(string)
 	}
 
+	updateCertAuthOCSPFields(d, data)
+
 	// Deprecated fields
 	if v, ok := d.GetOk("bound_cidrs"); ok {
 		data["bound_cidrs"] = v.(*schema.Set).List()
@@ -244,29 +338,7 @@ func certAuthResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	data["certificate"] = d.Get("certificate")
 
-	if v, ok := d.GetOk("allowed_names"); ok {
-		data["allowed_names"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("allowed_common_names"); ok {
-		data["allowed_common_names"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("allowed_dns_sans"); ok {
-		data["allowed_dns_sans"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("allowed_uri_sans"); ok {
-		data["allowed_uri_sans"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("allowed_organization_units"); ok {
-		data["allowed_organization_units"] = v.(*schema.Set).List()
-	}
-
-	if v, ok := d.GetOk("required_extensions"); ok {
-		data["required_extensions"] = v.(*schema.Set).List()
-	}
+	updateCertAuthSetFields(d, data)
 
 	if v, ok := d.GetOk("ttl"); ok {
 		data["ttl"] = v.(string)
@@ -288,6 +360,8 @@ func certAuthResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["display_name"] = v.(string)
 	}
 
+	updateCertAuthOCSPFields(d, data)
+
 	if v, ok := d.GetOk("bound_cidrs"); ok {
 		data["bound_cidrs"] = v.(*schema.Set).List()
 	}
@@ -390,70 +464,30 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("certificate", resp.Data["certificate"])
 	d.Set("display_name", resp.Data["display_name"])
 
-	// Vault sometimes returns these as null instead of an empty list.
-	if resp.Data["allowed_names"] != nil {
-		d.Set("allowed_names",
-			schema.NewSet(
-				schema.HashString, resp.Data["allowed_names"].([]interface{})))
-	} else {
-		d.Set("allowed_names",
-			schema.NewSet(
-				schema.HashString, []interface{}{}))
-	}
-
-	// Vault sometimes returns these as null instead of an empty list.
-	if resp.Data["allowed_dns_sans"] != nil {
-		d.Set("allowed_dns_sans",
-			schema.NewSet(
-				schema.HashString, resp.Data["allowed_dns_sans"].([]interface{})))
-	} else {
-		d.Set("allowed_dns_sans",
-			schema.NewSet(
-				schema.HashString, []interface{}{}))
-	}
-
-	// Vault sometimes returns these as null instead of an empty list.
-	if resp.Data["allowed_email_sans"] != nil {
-		d.Set("allowed_email_sans",
-			schema.NewSet(
-				schema.HashString, resp.Data["allowed_email_sans"].([]interface{})))
-	} else {
-		d.Set("allowed_email_sans",
-			schema.NewSet(
-				schema.HashString, []interface{}{}))
-	}
-
-	// Vault sometimes returns these as null instead of an empty list.
-	if resp.Data["allowed_uri_sans"] != nil {
-		d.Set("allowed_uri_sans",
-			schema.NewSet(
-				schema.HashString, resp.Data["allowed_uri_sans"].([]interface{})))
-	} else {
-		d.Set("allowed_uri_sans",
-			schema.NewSet(
-				schema.HashString, []interface{}{}))
+	for _, k := range certAuthOCSPFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for cert %q: %s", k, path, err)
+			}
+		}
 	}
 
-	// Vault sometimes returns these as null instead of an empty list.
-	if resp.Data["allowed_organization_units"] != nil {
-		d.Set("allowed_organization_units",
-			schema.NewSet(
-				schema.HashString, resp.Data["allowed_organization_units"].([]interface{})))
-	} else {
-		d.Set("allowed_organization_units",
-			schema.NewSet(
-				schema.HashString, []interface{}{}))
+	for _, k := range certAuthSetFields {
+		// Older versions of Vault don't return the newer fields at all.
+		if _, ok := resp.Data[k]; !ok {
+			continue
+		}
+		// Vault sometimes returns these as null instead of an empty list.
+		v, _ := resp.Data[k].([]interface{})
+		d.Set(k, schema.NewSet(schema.HashString, v))
 	}
 
-	// Vault sometimes returns these as null instead of an empty list.
-	if resp.Data["required_extensions"] != nil {
-		d.Set("required_extensions",
-			schema.NewSet(
-				schema.HashString, resp.Data["required_extensions"].([]interface{})))
-	} else {
-		d.Set("required_extensions",
-			schema.NewSet(
-				schema.HashString, []interface{}{}))
+	if _, deprecated := d.GetOk("allowed_organization_units"); deprecated {
+		v, ok := resp.Data["allowed_organizational_units"].([]interface{})
+		if !ok {
+			v, _ = resp.Data["allowed_organization_units"].([]interface{})
+		}
+		d.Set("allowed_organization_units", schema.NewSet(schema.HashString, v))
 	}
 
 	return nil
@@ -118,6 +118,126 @@ func TestCertAuthBackend(t *testing.T) {
 	})
 }
 
+func TestCertAuthBackend_ocsp(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-cert-auth")
+	name := acctest.RandomWithPrefix("tf-test-cert-name")
+	resName := "vault_cert_auth_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testCertAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCertAuthBackendConfig_ocsp(backend, name, testCertificate, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "ocsp_enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "ocsp_fail_open", "true"),
+					resource.TestCheckResourceAttr(resName, "ocsp_query_all_servers", "false"),
+					resource.TestCheckResourceAttr(resName, "ocsp_servers_override.#", "2"),
+				),
+			},
+			{
+				Config: testCertAuthBackendConfig_ocsp(backend, name, testCertificate, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "ocsp_enabled", "false"),
+					resource.TestCheckResourceAttr(resName, "ocsp_fail_open", "false"),
+					resource.TestCheckResourceAttr(resName, "ocsp_query_all_servers", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestCertAuthBackend_extensions(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-cert-auth")
+	name := acctest.RandomWithPrefix("tf-test-cert-name")
+	resName := "vault_cert_auth_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testCertAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCertAuthBackendConfig_extensions(backend, name, testCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					testCertAuthBackendCheck_attrs(backend, name),
+					resource.TestCheckResourceAttr(resName, "allowed_common_names.#", "1"),
+					resource.TestCheckResourceAttr(resName, "allowed_organizational_units.#", "1"),
+					resource.TestCheckResourceAttr(resName, "required_extensions.#", "2"),
+					resource.TestCheckResourceAttr(resName, "allowed_metadata_extensions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCertAuthBackendConfig_extensions(backend, name, certificate string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "cert" {
+    path = "%s"
+    type = "cert"
+}
+
+resource "vault_cert_auth_backend_role" "test" {
+    name                         = "%s"
+    certificate                  = <<__CERTIFICATE__
+%s
+__CERTIFICATE__
+    backend                      = vault_auth_backend.cert.path
+    allowed_common_names         = ["client.example.com"]
+    allowed_organizational_units = ["engineering"]
+    required_extensions          = ["1.2.3.4:foo", "2.1.1.1:*"]
+    allowed_metadata_extensions  = ["1.2.3.45"]
+}
+`, backend, name, certificate)
+}
+
+func TestCertAuthBackendExtensionValidation(t *testing.T) {
+	for _, v := range []string{"1.2.3.4:foo", "2.1.1.1:*"} {
+		if !certAuthRequiredExtensionRegexp.MatchString(v) {
+			t.Errorf("expected required extension %q to be valid", v)
+		}
+	}
+	for _, v := range []string{"1.2.3.4", "foo:bar", "1:foo", "1.2.:foo"} {
+		if certAuthRequiredExtensionRegexp.MatchString(v) {
+			t.Errorf("expected required extension %q to be invalid", v)
+		}
+	}
+	for _, v := range []string{"1.2.3.4", "2.5.29.17"} {
+		if !certAuthOIDRegexp.MatchString(v) {
+			t.Errorf("expected OID %q to be valid", v)
+		}
+	}
+	for _, v := range []string{"1", "1.2.3.4:foo", "1-2-3-4"} {
+		if certAuthOIDRegexp.MatchString(v) {
+			t.Errorf("expected OID %q to be invalid", v)
+		}
+	}
+}
+
+func testCertAuthBackendConfig_ocsp(backend, name, certificate string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "cert" {
+    path = "%s"
+    type = "cert"
+}
+
+resource "vault_cert_auth_backend_role" "test" {
+    name                   = "%s"
+    certificate            = <<__CERTIFICATE__
+%s
+__CERTIFICATE__
+    backend                = vault_auth_backend.cert.path
+    ocsp_enabled           = %[4]t
+    ocsp_fail_open         = %[4]t
+    ocsp_query_all_servers = !%[4]t
+    ocsp_servers_override  = ["http://ocsp1.example.com", "http://ocsp2.example.com"]
+}
+`, backend, name, certificate, enabled)
+}
+
 func TestCertAuthBackend_deprecated(t *testing.T) {
 	backend := acctest.RandomWithPrefix("tf-test-cert-auth")
 	name := acctest.RandomWithPrefix("tf-test-cert-name")
@@ -208,19 +328,21 @@ func testCertAuthBackendCheck_attrs(backend, name string) resource.TestCheckFunc
 		}
 
 		attrs := map[string]string{
-			"name":                       "display_name",
-			"allowed_names":              "allowed_names",
-			"allowed_dns_sans":           "allowed_dns_sans",
-			"allowed_email_sans":         "allowed_email_sans",
-			"allowed_uri_sans":           "allowed_uri_sans",
-			"allowed_organization_units": "allowed_organization_units",
-			"required_extensions":        "required_extensions",
-			"token_period":               "token_period",
-			"token_policies":             "token_policies",
-			"certificate":                "certificate",
-			"token_ttl":                  "token_ttl",
-			"token_max_ttl":              "token_max_ttl",
-			"token_bound_cidrs":          "token_bound_cidrs",
+			"name":                         "display_name",
+			"allowed_names":                "allowed_names",
+			"allowed_dns_sans":             "allowed_dns_sans",
+			"allowed_email_sans":           "allowed_email_sans",
+			"allowed_uri_sans":             "allowed_uri_sans",
+			"allowed_common_names":         "allowed_common_names",
+			"allowed_organizational_units": "allowed_organizational_units",
+			"required_extensions":          "required_extensions",
+			"allowed_metadata_extensions":  "allowed_metadata_extensions",
+			"token_period":                 "token_period",
+			"token_policies":               "token_policies",
+			"certificate":                  "certificate",
+			"token_ttl":                    "token_ttl",
+			"token_max_ttl":                "token_max_ttl",
+			"token_bound_cidrs":            "token_bound_cidrs",
 		}
 
 		for stateAttr, apiAttr := range attrs {
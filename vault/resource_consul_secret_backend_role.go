@@ -7,12 +7,18 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
 var (
 	consulSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	consulSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
+
+	// consulServiceIdentityRegex matches "<service>" or "<service>:<dc1>,<dc2>".
+	consulServiceIdentityRegex = regexp.MustCompile("^[^:,]+(:[^:,]+(,[^:,]+)*)?$")
+	// consulNodeIdentityRegex matches "<node>:<dc>".
+	consulNodeIdentityRegex = regexp.MustCompile("^[^:,]+:[^:,]+$")
 )
 
 func consulSecretBackendRoleResource() *schema.Resource {
@@ -49,12 +55,40 @@ func consulSecretBackendRoleResource() *schema.Resource {
 			},
 			"policies": {
 				Type:        schema.TypeList,
-				Required:    true,
+				Optional:    true,
 				Description: "List of Consul policies to associate with this role",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"service_identities": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of Service Identities to attach to the token, in the form <service>[:<datacenter1>,<datacenter2>]. Requires Consul 1.8+.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(consulServiceIdentityRegex, "must be of the form <service>[:<datacenter1>,<datacenter2>]"),
+				},
+			},
+			"node_identities": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of Node Identities to attach to the token, in the form <node>:<datacenter>. Requires Consul 1.8+.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(consulNodeIdentityRegex, "must be of the form <node>:<datacenter>"),
+				},
+			},
+			"consul_namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Consul namespace that the token will be created in. Requires Consul Enterprise 1.7+.",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Consul admin partition that the token will be created in. Requires Consul Enterprise 1.11+.",
+			},
 			"max_ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -106,9 +140,19 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 	path := consulSecretBackendRolePath(backend, name)
 
 	policies := d.Get("policies").([]interface{})
+	serviceIdentities := d.Get("service_identities").([]interface{})
+	nodeIdentities := d.Get("node_identities").([]interface{})
+
+	if d.Get("token_type").(string) == "client" && len(policies) == 0 && len(serviceIdentities) == 0 && len(nodeIdentities) == 0 {
+		return fmt.Errorf("at least one of policies, service_identities or node_identities is required for Consul secret backend role %s", name)
+	}
 
 	payload := map[string]interface{}{
-		"policies": policies,
+		"policies":           policies,
+		"service_identities": serviceIdentities,
+		"node_identities":    nodeIdentities,
+		"consul_namespace":   d.Get("consul_namespace"),
+		"partition":          d.Get("partition"),
 	}
 
 	if v, ok := d.GetOkExists("max_ttl"); ok {
@@ -173,6 +217,10 @@ func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("backend", backend)
 	}
 	d.Set("policies", data["policies"])
+	d.Set("service_identities", data["service_identities"])
+	d.Set("node_identities", data["node_identities"])
+	d.Set("consul_namespace", data["consul_namespace"])
+	d.Set("partition", data["partition"])
 	d.Set("max_ttl", data["max_ttl"])
 	d.Set("ttl", data["ttl"])
 	d.Set("token_type", data["token_type"])
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -49,6 +50,41 @@ func TestConsulSecretBackendRole(t *testing.T) {
 	})
 }
 
+func TestConsulSecretBackendRole_identities(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-backend")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	token := "026a0c16-87cd-4c2d-b3f3-fb539f592b7e"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConsulSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsulSecretBackendRole_identitiesConfig(backend, name, token, `
+  service_identities = ["web", "api:dc1,dc2"]
+  node_identities    = ["server-1:dc1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "policies.#", "0"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "service_identities.#", "2"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "service_identities.0", "web"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "service_identities.1", "api:dc1,dc2"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "node_identities.#", "1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "node_identities.0", "server-1:dc1"),
+				),
+			},
+			{
+				Config: testConsulSecretBackendRole_identitiesConfig(backend, name, token, `
+  node_identities = ["server-1"]`),
+				ExpectError: regexp.MustCompile("must be of the form <node>:<datacenter>"),
+			},
+			{
+				Config:      testConsulSecretBackendRole_identitiesConfig(backend, name, token, ""),
+				ExpectError: regexp.MustCompile("at least one of policies, service_identities or node_identities is required"),
+			},
+		},
+	})
+}
+
 func testAccConsulSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -133,6 +169,25 @@ resource "vault_consul_secret_backend_role" "test_path" {
 `, backend, token, name)
 }
 
+func testConsulSecretBackendRole_identitiesConfig(backend, name, token, identities string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds = 86400
+  address = "127.0.0.1:8500"
+  token = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend = vault_consul_secret_backend.test.path
+  name = "%s"
+%s
+}
+`, backend, token, name, identities)
+}
+
 func TestConsulSecretBackendRoleNameFromPath(t *testing.T) {
 	{
 		name, err := consulSecretBackendRoleNameFromPath("foo/roles/bar")
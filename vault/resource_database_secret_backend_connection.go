@@ -16,7 +16,22 @@ import (
 var (
 	databaseSecretBackendConnectionBackendFromPathRegex = regexp.MustCompile("^(.+)/config/.+$")
 	databaseSecretBackendConnectionNameFromPathRegex    = regexp.MustCompile("^.+/config/(.+$)")
-	dbBackendTypes                                      = []string{"cassandra", "hana", "mongodb", "mssql", "mysql", "mysql_rds", "mysql_aurora", "mysql_legacy", "postgresql", "oracle", "elasticsearch"}
+	dbBackendTypes                                      = []string{"cassandra", "hana", "mongodb", "mssql", "mysql", "mysql_rds", "mysql_aurora", "mysql_legacy", "postgresql", "oracle", "elasticsearch", "redis"}
+	dbPluginBlockNames                                  = map[string]string{
+		"cassandra-database-plugin":     "cassandra",
+		"hana-database-plugin":          "hana",
+		"mongodb-database-plugin":       "mongodb",
+		"mongodbatlas-database-plugin":  "mongodbatlas",
+		"mssql-database-plugin":         "mssql",
+		"mysql-database-plugin":         "mysql",
+		"mysql-rds-database-plugin":     "mysql_rds",
+		"mysql-aurora-database-plugin":  "mysql_aurora",
+		"mysql-legacy-database-plugin":  "mysql_legacy",
+		"oracle-database-plugin":        "oracle",
+		"postgresql-database-plugin":    "postgresql",
+		"elasticsearch-database-plugin": "elasticsearch",
+		"redis-database-plugin":         "redis",
+	}
 )
 
 func databaseSecretBackendConnectionResource() *schema.Resource {
@@ -67,102 +82,28 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 			},
 
 			"elasticsearch": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Connection parameters for the elasticsearch-database-plugin.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"url": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The URL for Elasticsearch's API",
-						},
-						"username": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The username to be used in the connection URL",
-						},
-						"password": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The password to be used in the connection URL",
-							Sensitive:   true,
-						},
-					},
-				},
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "Connection parameters for the elasticsearch-database-plugin.",
+				Elem:          elasticsearchConnectionResource(),
 				MaxItems:      1,
 				ConflictsWith: util.CalculateConflictsWith("elasticsearch", dbBackendTypes),
 			},
 
+			"redis": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "Connection parameters for the redis-database-plugin plugin.",
+				Elem:          redisConnectionResource(),
+				MaxItems:      1,
+				ConflictsWith: util.CalculateConflictsWith("redis", dbBackendTypes),
+			},
+
 			"cassandra": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Connection parameters for the cassandra-database-plugin plugin.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"hosts": {
-							Type: schema.TypeList,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Optional:    true,
-							Description: "Cassandra hosts to connect to.",
-						},
-						"port": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "The transport port to use to connect to Cassandra.",
-							Default:     9042,
-						},
-						"username": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The username to use when authenticating with Cassandra.",
-						},
-						"password": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The password to use when authenticating with Cassandra.",
-							Sensitive:   true,
-						},
-						"tls": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Whether to use TLS when connecting to Cassandra.",
-							Default:     true,
-						},
-						"insecure_tls": {
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Description: "Whether to skip verification of the server certificate when using TLS.",
-							Default:     false,
-						},
-						"pem_bundle": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Concatenated PEM blocks containing a certificate and private key; a certificate, private key, and issuing CA certificate; or just a CA certificate.",
-							Sensitive:   true,
-						},
-						"pem_json": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Specifies JSON containing a certificate and private key; a certificate, private key, and issuing CA certificate; or just a CA certificate.",
-							Sensitive:   true,
-						},
-						"protocol_version": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Default:     2,
-							Description: "The CQL protocol version to use.",
-						},
-						"connect_timeout": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Default:     5,
-							Description: "The number of seconds to use as a connection timeout.",
-						},
-					},
-				},
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "Connection parameters for the cassandra-database-plugin plugin.",
+				Elem:          cassandraConnectionResource(),
 				MaxItems:      1,
 				ConflictsWith: util.CalculateConflictsWith("cassandra", dbBackendTypes),
 			},
@@ -177,29 +118,10 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 			},
 
 			"mongodbatlas": {
-				Type:        schema.TypeList,
-				Optional:    true,
-				Description: "Connection parameters for the mongodbatlas-database-plugin plugin.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"private_key": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The Private Programmatic API Key used to connect with MongoDB Atlas API.",
-							Sensitive:   true,
-						},
-						"public_key": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The Public Programmatic API Key used to authenticate with the MongoDB Atlas API.",
-						},
-						"project_id": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The Project ID the Database User should be created within.",
-						},
-					},
-				},
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "Connection parameters for the mongodbatlas-database-plugin plugin.",
+				Elem:          mongodbAtlasConnectionResource(),
 				MaxItems:      1,
 				ConflictsWith: util.CalculateConflictsWith("mongodbatlas", dbBackendTypes),
 			},
@@ -273,6 +195,12 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 				ConflictsWith: util.CalculateConflictsWith("oracle", dbBackendTypes),
 			},
 
+			"plugin_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the database plugin used by this connection.",
+			},
+
 			"backend": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -287,6 +215,209 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 	}
 }
 
+func elasticsearchConnectionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL for Elasticsearch's API",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username to be used in the connection URL",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The password to be used in the connection URL",
+				Sensitive:   true,
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path to a PEM-encoded CA cert file to use to verify the Elasticsearch server's identity",
+			},
+			"ca_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path to a directory of PEM-encoded CA cert files to use to verify the Elasticsearch server's identity",
+			},
+			"client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path to the certificate for the Elasticsearch client to present for communication",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path to the key for the Elasticsearch client to use for communication",
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "This, if set, is used to set the SNI host when connecting via TLS",
+			},
+			"insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to disable certificate verification",
+				Default:     false,
+			},
+			"username_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Template describing how dynamic usernames are generated.",
+				ValidateFunc: validateUsernameTemplate,
+			},
+		},
+	}
+}
+
+func redisConnectionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The host to connect to.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The transport port to use to connect to Redis.",
+				Default:     6379,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username to use when authenticating with Redis.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The password to use when authenticating with Redis.",
+				Sensitive:   true,
+			},
+			"tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to use TLS when connecting to Redis.",
+				Default:     false,
+			},
+			"insecure_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to skip verification of the server certificate when using TLS.",
+				Default:     false,
+			},
+			"ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The contents of a PEM-encoded CA cert file to use to verify the Redis server's identity.",
+			},
+		},
+	}
+}
+
+func cassandraConnectionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hosts": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Cassandra hosts to connect to.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The transport port to use to connect to Cassandra.",
+				Default:     9042,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The username to use when authenticating with Cassandra.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The password to use when authenticating with Cassandra.",
+				Sensitive:   true,
+			},
+			"tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to use TLS when connecting to Cassandra.",
+				Default:     true,
+			},
+			"insecure_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to skip verification of the server certificate when using TLS.",
+				Default:     false,
+			},
+			"pem_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Concatenated PEM blocks containing a certificate and private key; a certificate, private key, and issuing CA certificate; or just a CA certificate.",
+				Sensitive:   true,
+			},
+			"pem_json": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies JSON containing a certificate and private key; a certificate, private key, and issuing CA certificate; or just a CA certificate.",
+				Sensitive:   true,
+			},
+			"protocol_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     2,
+				Description: "The CQL protocol version to use.",
+			},
+			"connect_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "The number of seconds to use as a connection timeout.",
+			},
+		},
+	}
+}
+
+func mongodbAtlasConnectionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Private Programmatic API Key used to connect with MongoDB Atlas API.",
+				Sensitive:   true,
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Public Programmatic API Key used to authenticate with the MongoDB Atlas API.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Project ID the Database User should be created within.",
+			},
+			"username_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Template describing how dynamic usernames are generated.",
+				ValidateFunc: validateUsernameTemplate,
+			},
+		},
+	}
+}
+
 func connectionStringResource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -311,6 +442,12 @@ func connectionStringResource() *schema.Resource {
 				Optional:    true,
 				Description: "Maximum number of seconds a connection may be reused.",
 			},
+			"username_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Template describing how dynamic usernames are generated.",
+				ValidateFunc: validateUsernameTemplate,
+			},
 		},
 	}
 }
@@ -341,6 +478,8 @@ func getDatabasePluginName(d *schema.ResourceData) (string, error) {
 		return "postgresql-database-plugin", nil
 	case len(d.Get("elasticsearch").([]interface{})) > 0:
 		return "elasticsearch-database-plugin", nil
+	case len(d.Get("redis").([]interface{})) > 0:
+		return "redis-database-plugin", nil
 	default:
 		return "", fmt.Errorf("at least one database plugin must be configured")
 	}
@@ -355,79 +494,107 @@ func getDatabaseAPIData(d *schema.ResourceData) (map[string]interface{}, error)
 		"plugin_name": plugin,
 	}
 
+	setDatabaseConnectionDataForPlugin(d, plugin, dbPluginBlockNames[plugin]+".0.", data)
+
+	return data, nil
+}
+
+func getDatabaseConnectionDetailsForPlugin(d *schema.ResourceData, plugin, prefix string, resp *api.Secret) ([]map[string]interface{}, error) {
 	switch plugin {
 	case "cassandra-database-plugin":
-		if v, ok := d.GetOk("cassandra.0.hosts"); ok {
-			log.Printf("[DEBUG] Cassandra hosts: %v", v.([]interface{}))
-			var hosts []string
-			for _, host := range v.([]interface{}) {
-				if v == nil {
-					continue
-				}
-				hosts = append(hosts, host.(string))
-			}
-			data["hosts"] = strings.Join(hosts, ",")
-		}
-		if v, ok := d.GetOkExists("cassandra.0.port"); ok {
-			data["port"] = v.(int)
-		}
-		if v, ok := d.GetOk("cassandra.0.username"); ok {
-			data["username"] = v.(string)
-		}
-		if v, ok := d.GetOk("cassandra.0.password"); ok {
-			data["password"] = v.(string)
-		}
-		if v, ok := d.GetOkExists("cassandra.0.tls"); ok {
-			data["tls"] = v.(bool)
-		}
-		if v, ok := d.GetOkExists("cassandra.0.insecure_tls"); ok {
-			data["insecure_tls"] = v.(bool)
-		}
-		if v, ok := d.GetOkExists("cassandra.0.pem_bundle"); ok {
-			data["pem_bundle"] = v.(string)
-		}
-		if v, ok := d.GetOkExists("cassandra.0.pem_json"); ok {
-			data["pem_json"] = v.(string)
-		}
-		if v, ok := d.GetOkExists("cassandra.0.protocol_version"); ok {
-			data["protocol_version"] = v.(int)
-		}
-		if v, ok := d.GetOkExists("cassandra.0.connect_timeout"); ok {
-			data["connect_timeout"] = v.(int)
-		}
-	case "hana-database-plugin":
-		setDatabaseConnectionData(d, "hana.0.", data)
-	case "mongodb-database-plugin":
-		setDatabaseConnectionData(d, "mongodb.0.", data)
+		return getCassandraConnectionDetailsFromResponse(d, prefix, resp)
 	case "mongodbatlas-database-plugin":
-		if v, ok := d.GetOk("mongodbatlas.0.public_key"); ok {
-			data["public_key"] = v.(string)
+		return getMongoDBAtlasConnectionDetailsFromResponse(d, prefix, resp)
+	case "elasticsearch-database-plugin":
+		return getElasticsearchConnectionDetailsFromResponse(d, prefix, resp), nil
+	case "redis-database-plugin":
+		return getRedisConnectionDetailsFromResponse(d, prefix, resp)
+	default:
+		return getConnectionDetailsFromResponse(d, prefix, resp), nil
+	}
+}
+
+func getCassandraConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) ([]map[string]interface{}, error) {
+	details := resp.Data["connection_details"]
+	data, ok := details.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	result := map[string]interface{}{}
+
+	if v, ok := data["hosts"]; ok {
+		result["hosts"] = strings.Split(v.(string), ",")
+	}
+	if v, ok := data["port"]; ok {
+		port, err := v.(json.Number).Int64()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected non-number %q returned as port from Vault: %s", v, err)
 		}
-		if v, ok := d.GetOk("mongodbatlas.0.private_key"); ok {
-			data["private_key"] = v.(string)
+		result["port"] = port
+	}
+	if v, ok := data["username"]; ok {
+		result["username"] = v.(string)
+	}
+	if v, ok := data["password"]; ok {
+		result["password"] = v.(string)
+	} else if v, ok := d.GetOk(prefix + "password"); ok {
+		// keep the password we have in state/config if the API doesn't return one
+		result["password"] = v.(string)
+	}
+	if v, ok := data["tls"]; ok {
+		result["tls"] = v.(bool)
+	}
+	if v, ok := data["insecure_tls"]; ok {
+		result["insecure_tls"] = v.(bool)
+	}
+	if v, ok := data["pem_bundle"]; ok {
+		result["pem_bundle"] = v.(string)
+	} else if v, ok := d.GetOk(prefix + "pem_bundle"); ok {
+		result["pem_bundle"] = v.(string)
+	}
+	if v, ok := data["pem_json"]; ok {
+		result["pem_json"] = v.(string)
+	} else if v, ok := d.GetOk(prefix + "pem_json"); ok {
+		result["pem_json"] = v.(string)
+	}
+	if v, ok := data["protocol_version"]; ok {
+		protocol, err := v.(json.Number).Int64()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected non-number %q returned as protocol_version from Vault: %s", v, err)
 		}
-		if v, ok := d.GetOk("mongodbatlas.0.project_id"); ok {
-			data["project_id"] = v.(string)
+		result["protocol_version"] = int64(protocol)
+	}
+	if v, ok := data["connect_timeout"]; ok {
+		timeout, err := v.(json.Number).Int64()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected non-number %q returned as connect_timeout from Vault: %s", v, err)
 		}
-	case "mssql-database-plugin":
-		setDatabaseConnectionData(d, "mssql.0.", data)
-	case "mysql-database-plugin":
-		setDatabaseConnectionData(d, "mysql.0.", data)
-	case "mysql-rds-database-plugin":
-		setDatabaseConnectionData(d, "mysql_rds.0.", data)
-	case "mysql-aurora-database-plugin":
-		setDatabaseConnectionData(d, "mysql_aurora.0.", data)
-	case "mysql-legacy-database-plugin":
-		setDatabaseConnectionData(d, "mysql_legacy.0.", data)
-	case "oracle-database-plugin":
-		setDatabaseConnectionData(d, "oracle.0.", data)
-	case "postgresql-database-plugin":
-		setDatabaseConnectionData(d, "postgresql.0.", data)
-	case "elasticsearch-database-plugin":
-		setElasticsearchDatabaseConnectionData(d, "elasticsearch.0.", data)
+		result["connect_timeout"] = timeout
+	}
+	return []map[string]interface{}{result}, nil
+}
+
+func getMongoDBAtlasConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) ([]map[string]interface{}, error) {
+	details := resp.Data["connection_details"]
+	data, ok := details.(map[string]interface{})
+	if !ok {
+		return nil, nil
 	}
+	result := map[string]interface{}{}
 
-	return data, nil
+	if v, ok := data["public_key"]; ok {
+		result["public_key"] = v.(string)
+	}
+	if v, ok := data["private_key"]; ok {
+		result["private_key"] = v.(string)
+	}
+	if v, ok := data["project_id"]; ok {
+		result["project_id"] = v.(string)
+	}
+	if v, ok := data["username_template"]; ok {
+		result["username_template"] = v.(string)
+	}
+	return []map[string]interface{}{result}, nil
 }
 
 func getConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) []map[string]interface{} {
@@ -468,6 +635,9 @@ func getConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, res
 			result["max_connection_lifetime"] = n.Seconds()
 		}
 	}
+	if v, ok := data["username_template"]; ok {
+		result["username_template"] = v.(string)
+	}
 	return []map[string]interface{}{result}
 }
 
@@ -496,9 +666,134 @@ func getElasticsearchConnectionDetailsFromResponse(d *schema.ResourceData, prefi
 		result["password"] = v.(string)
 	}
 
+	for _, k := range []string{"ca_cert", "ca_path", "client_cert", "client_key", "tls_server_name"} {
+		if v, ok := data[k]; ok {
+			result[k] = v.(string)
+		} else if v, ok := d.GetOk(prefix + k); ok {
+			result[k] = v.(string)
+		}
+	}
+	if v, ok := data["insecure"]; ok {
+		result["insecure"] = v.(bool)
+	}
+	if v, ok := data["username_template"]; ok {
+		result["username_template"] = v.(string)
+	}
+
 	return []map[string]interface{}{result}
 }
 
+func getRedisConnectionDetailsFromResponse(d *schema.ResourceData, prefix string, resp *api.Secret) ([]map[string]interface{}, error) {
+	details := resp.Data["connection_details"]
+	data, ok := details.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	result := map[string]interface{}{}
+
+	if v, ok := data["host"]; ok {
+		result["host"] = v.(string)
+	}
+	if v, ok := data["port"]; ok {
+		port, err := v.(json.Number).Int64()
+		if err != nil {
+			return nil, fmt.Errorf("unexpected non-number %q returned as port from Vault: %s", v, err)
+		}
+		result["port"] = port
+	}
+	if v, ok := data["username"]; ok {
+		result["username"] = v.(string)
+	}
+	// the password is never returned by the API, keep the one we have in state/config
+	if v, ok := d.GetOk(prefix + "password"); ok {
+		result["password"] = v.(string)
+	}
+	if v, ok := data["tls"]; ok {
+		result["tls"] = v.(bool)
+	}
+	if v, ok := data["insecure_tls"]; ok {
+		result["insecure_tls"] = v.(bool)
+	}
+	if v, ok := data["ca_cert"]; ok {
+		result["ca_cert"] = v.(string)
+	} else if v, ok := d.GetOk(prefix + "ca_cert"); ok {
+		result["ca_cert"] = v.(string)
+	}
+
+	return []map[string]interface{}{result}, nil
+}
+
+func setDatabaseConnectionDataForPlugin(d *schema.ResourceData, plugin, prefix string, data map[string]interface{}) {
+	switch plugin {
+	case "cassandra-database-plugin":
+		setCassandraDatabaseConnectionData(d, prefix, data)
+	case "mongodbatlas-database-plugin":
+		setMongoDBAtlasDatabaseConnectionData(d, prefix, data)
+	case "elasticsearch-database-plugin":
+		setElasticsearchDatabaseConnectionData(d, prefix, data)
+	case "redis-database-plugin":
+		setRedisDatabaseConnectionData(d, prefix, data)
+	default:
+		setDatabaseConnectionData(d, prefix, data)
+	}
+}
+
+func setCassandraDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	if v, ok := d.GetOk(prefix + "hosts"); ok {
+		log.Printf("[DEBUG] Cassandra hosts: %v", v.([]interface{}))
+		var hosts []string
+		for _, host := range v.([]interface{}) {
+			if v == nil {
+				continue
+			}
+			hosts = append(hosts, host.(string))
+		}
+		data["hosts"] = strings.Join(hosts, ",")
+	}
+	if v, ok := d.GetOkExists(prefix + "port"); ok {
+		data["port"] = v.(int)
+	}
+	if v, ok := d.GetOk(prefix + "username"); ok {
+		data["username"] = v.(string)
+	}
+	if v, ok := d.GetOk(prefix + "password"); ok {
+		data["password"] = v.(string)
+	}
+	if v, ok := d.GetOkExists(prefix + "tls"); ok {
+		data["tls"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists(prefix + "insecure_tls"); ok {
+		data["insecure_tls"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists(prefix + "pem_bundle"); ok {
+		data["pem_bundle"] = v.(string)
+	}
+	if v, ok := d.GetOkExists(prefix + "pem_json"); ok {
+		data["pem_json"] = v.(string)
+	}
+	if v, ok := d.GetOkExists(prefix + "protocol_version"); ok {
+		data["protocol_version"] = v.(int)
+	}
+	if v, ok := d.GetOkExists(prefix + "connect_timeout"); ok {
+		data["connect_timeout"] = v.(int)
+	}
+}
+
+func setMongoDBAtlasDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	if v, ok := d.GetOk(prefix + "public_key"); ok {
+		data["public_key"] = v.(string)
+	}
+	if v, ok := d.GetOk(prefix + "private_key"); ok {
+		data["private_key"] = v.(string)
+	}
+	if v, ok := d.GetOk(prefix + "project_id"); ok {
+		data["project_id"] = v.(string)
+	}
+	if v, ok := d.GetOk(prefix + "username_template"); ok {
+		data["username_template"] = v.(string)
+	}
+}
+
 func setDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
 	if v, ok := d.GetOk(prefix + "connection_url"); ok {
 		data["connection_url"] = v.(string)
@@ -512,6 +807,9 @@ func setDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[s
 	if v, ok := d.GetOkExists(prefix + "max_connection_lifetime"); ok {
 		data["max_connection_lifetime"] = fmt.Sprintf("%ds", v)
 	}
+	if v, ok := d.GetOk(prefix + "username_template"); ok {
+		data["username_template"] = v.(string)
+	}
 }
 
 func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
@@ -526,6 +824,44 @@ func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix strin
 	if v, ok := d.GetOk(prefix + "password"); ok {
 		data["password"] = v.(string)
 	}
+
+	for _, k := range []string{"ca_cert", "ca_path", "client_cert", "client_key", "tls_server_name"} {
+		if v, ok := d.GetOk(prefix + k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	if v, ok := d.GetOkExists(prefix + "insecure"); ok {
+		data["insecure"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk(prefix + "username_template"); ok {
+		data["username_template"] = v.(string)
+	}
+}
+
+func setRedisDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	if v, ok := d.GetOk(prefix + "host"); ok {
+		data["host"] = v.(string)
+	}
+	if v, ok := d.GetOk(prefix + "port"); ok {
+		data["port"] = v.(int)
+	}
+	if v, ok := d.GetOk(prefix + "username"); ok {
+		data["username"] = v.(string)
+	}
+	if v, ok := d.GetOk(prefix + "password"); ok {
+		data["password"] = v.(string)
+	}
+	if v, ok := d.GetOkExists(prefix + "tls"); ok {
+		data["tls"] = v.(bool)
+	}
+	if v, ok := d.GetOkExists(prefix + "insecure_tls"); ok {
+		data["insecure_tls"] = v.(bool)
+	}
+	if v, ok := d.GetOk(prefix + "ca_cert"); ok {
+		data["ca_cert"] = v.(string)
+	}
 }
 
 func databaseSecretBackendConnectionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -602,105 +938,13 @@ func databaseSecretBackendConnectionRead(d *schema.ResourceData, meta interface{
 		return nil
 	}
 
-	switch resp.Data["plugin_name"].(string) {
-	case "cassandra-database-plugin":
-		details := resp.Data["connection_details"]
-		data, ok := details.(map[string]interface{})
-		if ok {
-			result := map[string]interface{}{}
-
-			if v, ok := data["hosts"]; ok {
-				result["hosts"] = strings.Split(v.(string), ",")
-			}
-			if v, ok := data["port"]; ok {
-				port, err := v.(json.Number).Int64()
-				if err != nil {
-					return fmt.Errorf("unexpected non-number %q returned as port from Vault: %s", v, err)
-				}
-				result["port"] = port
-			}
-			if v, ok := data["username"]; ok {
-				result["username"] = v.(string)
-			}
-			if v, ok := data["password"]; ok {
-				result["password"] = v.(string)
-			} else if v, ok := d.GetOk("cassandra.0.password"); ok {
-				// keep the password we have in state/config if the API doesn't return one
-				result["password"] = v.(string)
-			}
-			if v, ok := data["tls"]; ok {
-				result["tls"] = v.(bool)
-			}
-			if v, ok := data["insecure_tls"]; ok {
-				result["insecure_tls"] = v.(bool)
-			}
-			if v, ok := data["pem_bundle"]; ok {
-				result["pem_bundle"] = v.(string)
-			} else if v, ok := d.GetOk("cassandra.0.pem_bundle"); ok {
-				result["pem_bundle"] = v.(string)
-			}
-			if v, ok := data["pem_json"]; ok {
-				result["pem_json"] = v.(string)
-			} else if v, ok := d.GetOk("cassandra.0.pem_json"); ok {
-				result["pem_json"] = v.(string)
-			}
-			if v, ok := data["protocol_version"]; ok {
-				protocol, err := v.(json.Number).Int64()
-				if err != nil {
-					return fmt.Errorf("unexpected non-number %q returned as protocol_version from Vault: %s", v, err)
-				}
-				result["protocol_version"] = int64(protocol)
-			}
-			if v, ok := data["connect_timeout"]; ok {
-				timeout, err := v.(json.Number).Int64()
-				if err != nil {
-					return fmt.Errorf("unexpected non-number %q returned as connect_timeout from Vault: %s", v, err)
-				}
-				result["connect_timeout"] = timeout
-			}
-			d.Set("cassandra", []map[string]interface{}{result})
-		}
-	case "hana-database-plugin":
-		d.Set("hana", getConnectionDetailsFromResponse(d, "hana.0.", resp))
-	case "mongodb-database-plugin":
-		d.Set("mongodb", getConnectionDetailsFromResponse(d, "mongodb.0.", resp))
-	case "mongodbatlas-database-plugin":
-		details := resp.Data["connection_details"]
-		data, ok := details.(map[string]interface{})
-		if ok {
-			result := map[string]interface{}{}
-
-			if v, ok := data["public_key"]; ok {
-				result["public_key"] = v.(string)
-			}
-			if v, ok := data["private_key"]; ok {
-				result["private_key"] = v.(string)
-			}
-			if v, ok := data["project_id"]; ok {
-				result["project_id"] = v.(string)
-			}
-			d.Set("mongodbatlas", []map[string]interface{}{result})
+	plugin := resp.Data["plugin_name"].(string)
+	if block, ok := dbPluginBlockNames[plugin]; ok {
+		details, err := getDatabaseConnectionDetailsForPlugin(d, plugin, block+".0.", resp)
+		if err != nil {
+			return fmt.Errorf("error reading response for %q: %s", path, err)
 		}
-	case "mssql-database-plugin":
-		d.Set("mssql", getConnectionDetailsFromResponse(d, "mssql.0.", resp))
-	case "mysql-database-plugin":
-		d.Set("mysql", getConnectionDetailsFromResponse(d, "mysql.0.", resp))
-	case "mysql-rds-database-plugin":
-		d.Set("mysql_rds", getConnectionDetailsFromResponse(d, "mysql_rds.0.", resp))
-	case "mysql-aurora-database-plugin":
-		d.Set("mysql_aurora", getConnectionDetailsFromResponse(d, "mysql_aurora.0.", resp))
-	case "mysql-legacy-database-plugin":
-		d.Set("mysql_legacy", getConnectionDetailsFromResponse(d, "mysql_legacy.0.", resp))
-	case "oracle-database-plugin":
-		d.Set("oracle", getConnectionDetailsFromResponse(d, "oracle.0.", resp))
-	case "postgresql-database-plugin":
-		d.Set("postgresql", getConnectionDetailsFromResponse(d, "postgresql.0.", resp))
-	case "elasticsearch-database-plugin":
-		d.Set("elasticsearch", getElasticsearchConnectionDetailsFromResponse(d, "elasticsearch.0.", resp))
-	}
-
-	if err != nil {
-		return fmt.Errorf("error reading response for %q: %s", path, err)
+		d.Set(block, details)
 	}
 
 	var roles []string
@@ -709,6 +953,7 @@ func databaseSecretBackendConnectionRead(d *schema.ResourceData, meta interface{
 	}
 
 	d.Set("allowed_roles", roles)
+	d.Set("plugin_name", resp.Data["plugin_name"])
 	d.Set("backend", backend)
 	d.Set("name", name)
 	d.Set("root_rotation_statements", resp.Data["root_credentials_rotate_statements"])
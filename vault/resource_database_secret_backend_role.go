@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -80,6 +81,19 @@ func databaseSecretBackendRoleResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Database statements to execute to renew a user.",
 			},
+			"credential_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the type of credential that will be generated for the role.",
+				ValidateFunc: validation.StringInSlice([]string{"password", "rsa_private_key", "client_certificate"}, false),
+			},
+			"credential_config": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Specifies the configuration for the given credential_type.",
+			},
 		},
 	}
 }
@@ -112,6 +126,16 @@ func databaseSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 	if v, ok := d.GetOkExists("renew_statements"); ok && v != "" {
 		data["renew_statements"] = v
 	}
+	if v, ok := d.GetOk("credential_type"); ok {
+		data["credential_type"] = v.(string)
+	}
+	if v, ok := d.GetOk("credential_config"); ok {
+		credentialConfig := map[string]string{}
+		for k, val := range v.(map[string]interface{}) {
+			credentialConfig[k] = val.(string)
+		}
+		data["credential_config"] = credentialConfig
+	}
 
 	log.Printf("[DEBUG] Creating role %q on database backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
@@ -194,6 +218,18 @@ func databaseSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 	}
 	d.Set("renew_statements", renew)
 
+	if v, ok := secret.Data["credential_type"]; ok {
+		d.Set("credential_type", v)
+	}
+	if v, ok := secret.Data["credential_config"].(map[string]interface{}); ok {
+		// Vault may return non-string values, e.g. key_bits, keep them as strings
+		credentialConfig := map[string]string{}
+		for k, val := range v {
+			credentialConfig[k] = fmt.Sprintf("%v", val)
+		}
+		d.Set("credential_config", credentialConfig)
+	}
+
 	if v, ok := secret.Data["default_ttl"]; ok {
 		n, err := v.(json.Number).Int64()
 		if err != nil {
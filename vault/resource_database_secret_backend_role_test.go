@@ -33,6 +33,7 @@ func TestAccDatabaseSecretBackendRole_import(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "default_ttl", "3600"),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "max_ttl", "7200"),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", "SELECT 1;"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "credential_type", "password"),
 				),
 			},
 			{
@@ -67,6 +68,7 @@ func TestAccDatabaseSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "default_ttl", "3600"),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "max_ttl", "7200"),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", "SELECT 1;"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "credential_type", "password"),
 				),
 			},
 			{
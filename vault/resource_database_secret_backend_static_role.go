@@ -47,9 +47,10 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 				Description: "The database username that this role corresponds to.",
 			},
 			"rotation_period": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The amount of time Vault should wait before rotating the password, in seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"rotation_period", "rotation_schedule"},
+				Description:  "The amount of time Vault should wait before rotating the password, in seconds.",
 				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
 					value := v.(int)
 					if value < 5 {
@@ -58,6 +59,26 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 					return
 				},
 			},
+			"rotation_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"rotation_period", "rotation_schedule"},
+				Description:  "A cron-style string that will define the schedule on which rotations should occur.",
+				ValidateFunc: validateCronSchedule,
+			},
+			"rotation_window": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_period"},
+				Description:   "The amount of time in seconds in which the rotations are allowed to occur starting from a given rotation_schedule.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					value := v.(int)
+					if value < 3600 {
+						errs = append(errs, fmt.Errorf("The minimum value of rotation_window is 3600 seconds."))
+					}
+					return
+				},
+			},
 			"db_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -70,6 +91,12 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Database statements to execute to rotate the password for the configured database user.",
 			},
+			"rotate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Rotate the password of the static role immediately when this value is toggled.",
+			},
 		},
 	}
 }
@@ -81,14 +108,26 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 	name := d.Get("name").(string)
 
 	path := databaseSecretBackendStaticRolePath(backend, name)
+	create := d.Id() == ""
 
 	data := map[string]interface{}{
 		"username":            d.Get("username"),
-		"rotation_period":     d.Get("rotation_period"),
 		"db_name":             d.Get("db_name"),
 		"rotation_statements": []string{},
 	}
 
+	// Vault clears the rotation schedule when a rotation period is set and
+	// vice versa, so only the configured one is sent.
+	if v, ok := d.GetOk("rotation_period"); ok {
+		data["rotation_period"] = v
+	}
+	if v, ok := d.GetOk("rotation_schedule"); ok {
+		data["rotation_schedule"] = v
+		if v, ok := d.GetOk("rotation_window"); ok {
+			data["rotation_window"] = v
+		}
+	}
+
 	if v, ok := d.GetOkExists("rotation_statements"); ok && v != "" {
 		data["rotation_statements"] = v
 	}
@@ -101,6 +140,18 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Created static role %q on AWS backend %q", name, backend)
 
 	d.SetId(path)
+
+	// Vault rotates the password when the role is created, so only
+	// subsequent toggles trigger a rotation.
+	if !create && d.HasChange("rotate") {
+		rotatePath := databaseSecretBackendStaticRoleRotatePath(backend, name)
+		log.Printf("[DEBUG] Rotating static role %q on database backend %q", name, backend)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating static role %q for backend %q: %s", name, backend, err)
+		}
+		log.Printf("[DEBUG] Rotated static role %q on database backend %q", name, backend)
+	}
+
 	return databaseSecretBackendStaticRoleRead(d, meta)
 }
 
@@ -148,6 +199,18 @@ func databaseSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{
 		d.Set("rotation_period", n)
 	}
 
+	// Vault versions prior to 1.15 do not support rotation schedules.
+	if v, ok := role.Data["rotation_schedule"]; ok {
+		d.Set("rotation_schedule", v)
+	}
+	if v, ok := role.Data["rotation_window"]; ok {
+		n, err := v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for rotation_window of %q", v, path)
+		}
+		d.Set("rotation_window", n)
+	}
+
 	var rotation []string
 	if rotationStr, ok := role.Data["rotation_statements"].(string); ok {
 		rotation = append(rotation, rotationStr)
@@ -194,6 +257,10 @@ func databaseSecretBackendStaticRolePath(backend, name string) string {
 	return strings.Trim(backend, "/") + "/static-roles/" + strings.Trim(name, "/")
 }
 
+func databaseSecretBackendStaticRoleRotatePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/rotate-role/" + strings.Trim(name, "/")
+}
+
 func databaseSecretBackendStaticRoleNameFromPath(path string) (string, error) {
 	if !databaseSecretBackendStaticRoleNameFromPathRegex.MatchString(path) {
 		return "", fmt.Errorf("no name found")
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -96,6 +97,107 @@ func TestAccDatabaseSecretBackendStaticRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseSecretBackendStaticRole_rotationSchedule(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("staticrole")
+	username := acctest.RandomWithPrefix("user")
+	dbName := acctest.RandomWithPrefix("db")
+
+	if err := createTestUser(connURL, username); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_rotationSchedule(name, username, dbName, backend, connURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_schedule", "0 2 * * *"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_window", "7200"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_period", "0"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_basic(name, username, dbName, backend, connURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_period", "3600"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_schedule", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDatabaseSecretBackendStaticRole_rotate(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("staticrole")
+	username := acctest.RandomWithPrefix("user")
+	dbName := acctest.RandomWithPrefix("db")
+
+	if err := createTestUser(connURL, username); err != nil {
+		t.Fatal(err)
+	}
+
+	var lastRotation string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_rotate(name, username, dbName, backend, connURL, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotate", "false"),
+					testAccDatabaseSecretBackendStaticRoleLastRotation(backend, name, func(v string) error {
+						lastRotation = v
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_rotate(name, username, dbName, backend, connURL, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotate", "true"),
+					testAccDatabaseSecretBackendStaticRoleLastRotation(backend, name, func(v string) error {
+						if v == lastRotation {
+							return fmt.Errorf("expected static role %q to be rotated, last rotation is still %s", name, v)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseSecretBackendStaticRoleLastRotation(backend, name string, check func(string) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		path := strings.Trim(backend, "/") + "/static-creds/" + name
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading static credentials %q: %s", path, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("static credentials %q not found", path)
+		}
+
+		return check(fmt.Sprintf("%v", resp.Data["last_vault_rotation"]))
+	}
+}
+
 func testAccDatabaseSecretBackendStaticRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -195,3 +297,61 @@ resource "vault_database_secret_backend_static_role" "test" {
 }
 `, path, db, connURL, name, username)
 }
+
+func testAccDatabaseSecretBackendStaticRoleConfig_rotationSchedule(name, username, db, path, connURL string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = "${vault_mount.db.path}"
+  name = "%s"
+  allowed_roles = ["*"]
+
+  mysql {
+	  connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_static_role" "test" {
+  backend = "${vault_mount.db.path}"
+  db_name = "${vault_database_secret_backend_connection.test.name}"
+  name = "%s"
+  username = "%s"
+  rotation_schedule = "0 2 * * *"
+  rotation_window = 7200
+  rotation_statements = ["ALTER USER '{{username}}'@'localhost' IDENTIFIED BY '{{password}}';"]
+}
+`, path, db, connURL, name, username)
+}
+
+func testAccDatabaseSecretBackendStaticRoleConfig_rotate(name, username, db, path, connURL string, rotate bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = "${vault_mount.db.path}"
+  name = "%s"
+  allowed_roles = ["*"]
+
+  mysql {
+	  connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_static_role" "test" {
+  backend = "${vault_mount.db.path}"
+  db_name = "${vault_database_secret_backend_connection.test.name}"
+  name = "%s"
+  username = "%s"
+  rotation_period = 3600
+  rotation_statements = ["ALTER USER '{{username}}'@'localhost' IDENTIFIED BY '{{password}}';"]
+  rotate = %t
+}
+`, path, db, connURL, name, username, rotate)
+}
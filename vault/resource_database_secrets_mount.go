@@ -0,0 +1,329 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func databaseSecretsMountResource() *schema.Resource {
+	s := getMountSchema("type")
+	for plugin, block := range dbPluginBlockNames {
+		s[block] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: fmt.Sprintf("Connections for the %s plugin.", plugin),
+			Elem:        databaseSecretsMountConnectionResource(block),
+		}
+	}
+
+	return &schema.Resource{
+		Create: databaseSecretsMountCreate,
+		Read:   databaseSecretsMountRead,
+		Update: databaseSecretsMountUpdate,
+		Delete: databaseSecretsMountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func databaseSecretsMountConnectionResource(block string) *schema.Resource {
+	var r *schema.Resource
+	switch block {
+	case "cassandra":
+		r = cassandraConnectionResource()
+	case "mongodbatlas":
+		r = mongodbAtlasConnectionResource()
+	case "elasticsearch":
+		r = elasticsearchConnectionResource()
+	case "redis":
+		r = redisConnectionResource()
+	default:
+		r = connectionStringResource()
+	}
+
+	r.Schema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the database connection.",
+	}
+	r.Schema["plugin_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the database plugin used by this connection.",
+	}
+	r.Schema["verify_connection"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Specifies if the connection is verified during initial configuration.",
+		Default:     true,
+	}
+	r.Schema["allowed_roles"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "A list of roles that are allowed to use this connection.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	r.Schema["root_rotation_statements"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "A list of database statements to be executed to rotate the root user's credentials.",
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	r.Schema["data"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Description: "A map of sensitive data to pass to the endpoint. Useful for templated connection strings.",
+		Sensitive:   true,
+	}
+
+	return r
+}
+
+func databaseSecretsMountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	if err := createMount(d, client, path, "database"); err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	for plugin, block := range dbPluginBlockNames {
+		for i := range d.Get(block).([]interface{}) {
+			if err := databaseSecretsMountWriteConnection(d, client, plugin, fmt.Sprintf("%s.%d.", block, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return databaseSecretsMountRead(d, meta)
+}
+
+func databaseSecretsMountWriteConnection(d *schema.ResourceData, client *api.Client, plugin, prefix string) error {
+	name := d.Get(prefix + "name").(string)
+	path := databaseSecretBackendConnectionPath(d.Id(), name)
+
+	data := map[string]interface{}{
+		"plugin_name": plugin,
+	}
+	setDatabaseConnectionDataForPlugin(d, plugin, prefix, data)
+
+	data["verify_connection"] = d.Get(prefix + "verify_connection").(bool)
+
+	if v, ok := d.GetOk(prefix + "allowed_roles"); ok {
+		var roles []string
+		for _, role := range v.([]interface{}) {
+			roles = append(roles, role.(string))
+		}
+		data["allowed_roles"] = strings.Join(roles, ",")
+	}
+
+	if v, ok := d.GetOk(prefix + "root_rotation_statements"); ok {
+		data["root_rotation_statements"] = v
+	}
+
+	if m, ok := d.GetOk(prefix + "data"); ok {
+		for k, v := range m.(map[string]interface{}) {
+			data[k] = v.(string)
+		}
+	}
+
+	log.Printf("[DEBUG] Writing connection config to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error configuring database connection %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote database connection config %q", path)
+
+	return nil
+}
+
+func databaseSecretsMountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	if err := readMount(d, client, true); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	path := d.Id()
+	configPath := strings.Trim(path, "/") + "/config"
+
+	log.Printf("[DEBUG] Listing database connections under %q", configPath)
+	resp, err := client.Logical().List(configPath)
+	if err != nil {
+		return fmt.Errorf("error listing database connections under %q: %s", configPath, err)
+	}
+
+	var names []string
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			for _, k := range keys {
+				names = append(names, k.(string))
+			}
+		}
+	}
+
+	// prefixes of the connections that are already in the state, by block and name
+	prefixes := map[string]map[string]string{}
+	for _, block := range dbPluginBlockNames {
+		prefixes[block] = map[string]string{}
+		for i := range d.Get(block).([]interface{}) {
+			prefix := fmt.Sprintf("%s.%d.", block, i)
+			prefixes[block][d.Get(prefix+"name").(string)] = prefix
+		}
+	}
+
+	blocks := map[string]string{}
+	read := map[string]map[string]interface{}{}
+	for _, name := range names {
+		connPath := databaseSecretBackendConnectionPath(path, name)
+
+		log.Printf("[DEBUG] Reading database connection config %q", connPath)
+		resp, err := client.Logical().Read(connPath)
+		if err != nil {
+			return fmt.Errorf("error reading database connection config %q: %s", connPath, err)
+		}
+		if resp == nil {
+			continue
+		}
+
+		plugin, ok := resp.Data["plugin_name"].(string)
+		if !ok {
+			log.Printf("[WARN] Ignoring database connection %q without a plugin name", connPath)
+			continue
+		}
+		block, ok := dbPluginBlockNames[plugin]
+		if !ok {
+			log.Printf("[WARN] Ignoring database connection %q with unsupported plugin %q", connPath, plugin)
+			continue
+		}
+
+		// connections that are not in the state yet, e.g. on import, have no
+		// prefix and only get what Vault returns.
+		prefix, ok := prefixes[block][name]
+		if !ok {
+			prefix = fmt.Sprintf("%s.%d.", block, len(d.Get(block).([]interface{})))
+		}
+
+		details, err := getDatabaseConnectionDetailsForPlugin(d, plugin, prefix, resp)
+		if err != nil {
+			return fmt.Errorf("error reading response for %q: %s", connPath, err)
+		}
+		result := map[string]interface{}{}
+		if len(details) > 0 {
+			result = details[0]
+		}
+
+		var roles []string
+		if v, ok := resp.Data["allowed_roles"].([]interface{}); ok {
+			for _, role := range v {
+				roles = append(roles, role.(string))
+			}
+		}
+
+		result["name"] = name
+		result["plugin_name"] = plugin
+		result["allowed_roles"] = roles
+		result["root_rotation_statements"] = resp.Data["root_credentials_rotate_statements"]
+		if v, ok := resp.Data["verify_connection"]; ok {
+			result["verify_connection"] = v.(bool)
+		} else {
+			result["verify_connection"] = d.Get(prefix + "verify_connection")
+		}
+		// data is never returned by Vault, keep what we have in the state
+		if v, ok := d.GetOk(prefix + "data"); ok {
+			result["data"] = v
+		}
+
+		blocks[name] = block
+		read[name] = result
+	}
+
+	// connections are kept in the order they are in the state, new ones are
+	// appended in the order Vault lists them.
+	connections := map[string][]map[string]interface{}{}
+	for _, block := range dbPluginBlockNames {
+		connections[block] = []map[string]interface{}{}
+		for i := range d.Get(block).([]interface{}) {
+			name := d.Get(fmt.Sprintf("%s.%d.name", block, i)).(string)
+			if blocks[name] == block {
+				connections[block] = append(connections[block], read[name])
+				delete(blocks, name)
+			}
+		}
+	}
+	for _, name := range names {
+		if block, ok := blocks[name]; ok {
+			connections[block] = append(connections[block], read[name])
+		}
+	}
+
+	for block, v := range connections {
+		if err := d.Set(block, v); err != nil {
+			return fmt.Errorf("error setting %q for database mount %q: %s", block, path, err)
+		}
+	}
+
+	return nil
+}
+
+func databaseSecretsMountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	if err := updateMount(d, client); err != nil {
+		return err
+	}
+
+	path := d.Id()
+	for plugin, block := range dbPluginBlockNames {
+		if !d.HasChange(block) {
+			continue
+		}
+
+		o, n := d.GetChange(block)
+		current := map[string]bool{}
+		for _, v := range n.([]interface{}) {
+			current[v.(map[string]interface{})["name"].(string)] = true
+		}
+		for _, v := range o.([]interface{}) {
+			name := v.(map[string]interface{})["name"].(string)
+			if current[name] {
+				continue
+			}
+
+			connPath := databaseSecretBackendConnectionPath(path, name)
+			log.Printf("[DEBUG] Removing database connection config %q", connPath)
+			if _, err := client.Logical().Delete(connPath); err != nil {
+				return fmt.Errorf("error removing database connection config %q: %s", connPath, err)
+			}
+			log.Printf("[DEBUG] Removed database connection config %q", connPath)
+		}
+
+		for i := range n.([]interface{}) {
+			if err := databaseSecretsMountWriteConnection(d, client, plugin, fmt.Sprintf("%s.%d.", block, i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return databaseSecretsMountRead(d, meta)
+}
+
+func databaseSecretsMountDelete(d *schema.ResourceData, meta interface{}) error {
+	// unmounting the engine removes all of its connections
+	return mountDelete(d, meta)
+}
@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccDatabaseSecretsMount_postgresql(t *testing.T) {
+	connURL := os.Getenv("POSTGRES_URL")
+	if connURL == "" {
+		t.Skip("POSTGRES_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretsMountCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretsMountConfig_postgresql(backend, name, connURL, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "path", backend),
+					resource.TestCheckResourceAttrSet("vault_database_secrets_mount.test", "accessor"),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.#", "1"),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.0.name", name+"-0"),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.0.plugin_name", "postgresql-database-plugin"),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.0.connection_url", connURL),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.0.allowed_roles.#", "2"),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.0.verify_connection", "true"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretsMountConfig_postgresql(backend, name, connURL, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.#", "2"),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.0.name", name+"-0"),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.1.name", name+"-1"),
+					testAccDatabaseSecretsMountCheckConnections(backend, 2),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretsMountConfig_postgresql(backend, name, connURL, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.#", "1"),
+					resource.TestCheckResourceAttr("vault_database_secrets_mount.test", "postgresql.0.name", name+"-0"),
+					testAccDatabaseSecretsMountCheckConnections(backend, 1),
+				),
+			},
+			{
+				ResourceName:            "vault_database_secrets_mount.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"postgresql.0.connection_url"},
+			},
+		},
+	})
+}
+
+func testAccDatabaseSecretsMountCheckConnections(path string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		resp, err := client.Logical().List(path + "/config")
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("no connections found under %q", path)
+		}
+		if keys := resp.Data["keys"].([]interface{}); len(keys) != expected {
+			return fmt.Errorf("expected %d connections under %q, got %d", expected, path, len(keys))
+		}
+		return nil
+	}
+}
+
+func testAccDatabaseSecretsMountCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_database_secrets_mount" {
+			continue
+		}
+		if _, ok := mounts[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("mount %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccDatabaseSecretsMountConfig_postgresql(path, name, connURL string, count int) string {
+	var connections string
+	for i := 0; i < count; i++ {
+		connections += fmt.Sprintf(`
+  postgresql {
+    name           = "%s-%d"
+    connection_url = "%s"
+    allowed_roles  = ["dev", "prod"]
+  }
+`, name, i, connURL)
+	}
+
+	return fmt.Sprintf(`
+resource "vault_database_secrets_mount" "test" {
+  path = "%s"
+%s
+}
+`, path, connections)
+}
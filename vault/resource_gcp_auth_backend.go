@@ -68,10 +68,42 @@ func gcpAuthBackendResource() *schema.Resource {
 				Optional:    true,
 				Description: "Specifies if the auth method is local only",
 			},
+			"custom_endpoint": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Specifies overrides to service endpoints used when making API requests to GCP.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Replaces the service endpoint used in API requests to `https://www.googleapis.com`.",
+						},
+						"iam": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Replaces the service endpoint used in API requests to `https://iam.googleapis.com`.",
+						},
+						"crm": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Replaces the service endpoint used in API requests to `https://cloudresourcemanager.googleapis.com`.",
+						},
+						"compute": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Replaces the service endpoint used in API requests to `https://compute.googleapis.com`.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+var gcpAuthCustomEndpointFields = []string{"api", "iam", "crm", "compute"}
+
 func ValidateCredentials(configI interface{}, k string) ([]string, []error) {
 	credentials := configI.(string)
 	dataMap := map[string]interface{}{}
@@ -88,9 +120,11 @@ func NormalizeCredentials(configI interface{}) string {
 	dataMap := map[string]interface{}{}
 	err := json.Unmarshal([]byte(credentials), &dataMap)
 	if err != nil {
-		// The validate function should've taken care of this.
+		// The validate function should've taken care of this. Keep the
+		// original value so that it is reported by the validator rather
+		// than being replaced with an empty string.
 		log.Printf("[ERROR] Invalid JSON data in vault_gcp_auth_backend: %s", err)
-		return ""
+		return credentials
 	}
 
 	ret, err := json.Marshal(dataMap)
@@ -141,6 +175,14 @@ func gcpAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["credentials"] = v.(string)
 	}
 
+	if _, ok := d.GetOk("custom_endpoint"); ok || d.HasChange("custom_endpoint") {
+		endpoints := map[string]interface{}{}
+		for _, k := range gcpAuthCustomEndpointFields {
+			endpoints[k] = d.Get("custom_endpoint.0." + k).(string)
+		}
+		data["custom_endpoint"] = endpoints
+	}
+
 	log.Printf("[DEBUG] Writing gcp config %q", path)
 	_, err := client.Logical().Write(path, data)
 
@@ -175,6 +217,19 @@ func gcpAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("project_id", resp.Data["project_id"])
 	d.Set("client_email", resp.Data["client_email"])
 	d.Set("local", resp.Data["local"])
+
+	if v, ok := resp.Data["custom_endpoint"].(map[string]interface{}); ok && len(v) > 0 {
+		endpoints := map[string]interface{}{}
+		for _, k := range gcpAuthCustomEndpointFields {
+			endpoints[k] = v[k]
+		}
+		if err := d.Set("custom_endpoint", []map[string]interface{}{endpoints}); err != nil {
+			return fmt.Errorf("error setting custom_endpoint for gcp auth backend %q: %s", path, err)
+		}
+	} else {
+		d.Set("custom_endpoint", nil)
+	}
+
 	return nil
 }
 
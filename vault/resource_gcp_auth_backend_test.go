@@ -38,6 +38,57 @@ func TestGCPAuthBackend_basic(t *testing.T) {
 	})
 }
 
+func TestGCPAuthBackend_customEndpoint(t *testing.T) {
+	resName := "vault_gcp_auth_backend.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testGCPAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPAuthBackendConfig_customEndpoint(gcpJSONCredentials),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "custom_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resName, "custom_endpoint.0.api", "www.googleapis.com"),
+					resource.TestCheckResourceAttr(resName, "custom_endpoint.0.iam", "iam.googleapis.com"),
+					resource.TestCheckResourceAttr(resName, "custom_endpoint.0.crm", "cloudresourcemanager.googleapis.com"),
+					resource.TestCheckResourceAttr(resName, "custom_endpoint.0.compute", "compute.googleapis.com"),
+				),
+			},
+			{
+				Config: testGCPAuthBackendConfig_basic(gcpJSONCredentials),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "custom_endpoint.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestGCPAuthBackend_invalidCredentials(t *testing.T) {
+	credentials := `{"type": "service_account", "project_id": "terraform-vault-provider-a13efc8a",}`
+
+	if _, errs := ValidateCredentials(credentials, "credentials"); len(errs) == 0 {
+		t.Fatalf("expected a validation error for credentials %q", credentials)
+	}
+
+	if actual := NormalizeCredentials(credentials); actual != credentials {
+		t.Fatalf("expected invalid credentials to be preserved, got %q", actual)
+	}
+}
+
+func TestGCPAuthBackend_normalizeCredentials(t *testing.T) {
+	credentials := `{
+  "type": "service_account",
+  "project_id": "terraform-vault-provider-a13efc8a"
+}`
+	expected := `{"project_id":"terraform-vault-provider-a13efc8a","type":"service_account"}`
+
+	if actual := NormalizeCredentials(credentials); actual != expected {
+		t.Fatalf("expected normalized credentials %q, got %q", expected, actual)
+	}
+}
+
 func testGCPAuthBackendDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -85,3 +136,23 @@ resource "vault_gcp_auth_backend" "test" {
 `, credentials)
 
 }
+
+func testGCPAuthBackendConfig_customEndpoint(credentials string) string {
+	return fmt.Sprintf(`
+variable "json_credentials" {
+  type = "string"
+  default = %q
+}
+
+resource "vault_gcp_auth_backend" "test" {
+  credentials = "${var.json_credentials}"
+
+  custom_endpoint {
+    api     = "www.googleapis.com"
+    iam     = "iam.googleapis.com"
+    crm     = "cloudresourcemanager.googleapis.com"
+    compute = "compute.googleapis.com"
+  }
+}
+`, credentials)
+}
@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+// This test requires that the service account in the credentials passed is allowed to create
+// tokens for itself (roles/iam.serviceAccountTokenCreator). The impersonated account targets
+// that same service account.
+func TestGCPSecretImpersonatedAccount(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	impersonatedAccount := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+
+	var creds struct {
+		ClientEmail string `json:"client_email"`
+	}
+	if err := json.Unmarshal([]byte(credentials), &creds); err != nil {
+		t.Fatalf("error decoding GOOGLE_CREDENTIALS: %s", err)
+	}
+	if creds.ClientEmail == "" {
+		t.Skip("GOOGLE_CREDENTIALS does not contain a client_email")
+	}
+
+	resourceName := "vault_gcp_secret_backend_impersonated_account.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testGCPSecretImpersonatedAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretImpersonatedAccount_config(backend, impersonatedAccount, credentials, creds.ClientEmail, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/impersonated-account/"+impersonatedAccount),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "impersonated_account", impersonatedAccount),
+					resource.TestCheckResourceAttr(resourceName, "service_account_email", creds.ClientEmail),
+					resource.TestCheckResourceAttr(resourceName, "service_account_project", project),
+					resource.TestCheckResourceAttr(resourceName, "token_scopes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "token_scopes.2400041053", "https://www.googleapis.com/auth/cloud-platform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testGCPSecretImpersonatedAccount_config(backend, impersonatedAccount, credentials, creds.ClientEmail, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_account_email", creds.ClientEmail),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func testGCPSecretImpersonatedAccountDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcp_secret_backend_impersonated_account" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for GCP Secrets Impersonated Account %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("GCP Secrets Impersonated Account %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testGCPSecretImpersonatedAccount_config(backend, impersonatedAccount, credentials, email string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_backend_impersonated_account" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  impersonated_account = "%s"
+  service_account_email = "%s"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+  ttl = %d
+}
+`, backend, credentials, impersonatedAccount, email, ttl)
+}
@@ -100,6 +100,12 @@ func gcpSecretRolesetResource() *schema.Resource {
 				Computed:    true,
 				Description: "Email of the service account created by Vault for this Roleset",
 			},
+			"rotate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Rotate the service account of the roleset when this value is toggled.",
+			},
 		},
 
 		CustomizeDiff: customdiff.ComputedIf("service_account_email", func(d *schema.ResourceDiff, meta interface{}) bool {
@@ -110,7 +116,7 @@ func gcpSecretRolesetResource() *schema.Resource {
 			oldHcl := renderBindingsFromData(oldBinding)
 			newHcl := renderBindingsFromData(newBinding)
 
-			return d.HasChange("token_scopes") || oldHcl != newHcl
+			return d.HasChange("token_scopes") || d.HasChange("rotate") || oldHcl != newHcl
 		}),
 	}
 }
@@ -202,16 +208,26 @@ func gcpSecretRolesetUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	path := d.Id()
 
-	data := map[string]interface{}{}
-	gcpSecretRolesetUpdateFields(d, data)
+	if d.HasChanges("secret_type", "project", "token_scopes", "binding") {
+		data := map[string]interface{}{}
+		gcpSecretRolesetUpdateFields(d, data)
 
-	log.Printf("[DEBUG] Updating GCP Secrets backend roleset %q", path)
+		log.Printf("[DEBUG] Updating GCP Secrets backend roleset %q", path)
 
-	_, err := client.Logical().Write(path, data)
-	if err != nil {
-		return fmt.Errorf("Error updating GCP Secrets backend roleset %q: %s", path, err)
+		_, err := client.Logical().Write(path, data)
+		if err != nil {
+			return fmt.Errorf("Error updating GCP Secrets backend roleset %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated GCP Secrets backend roleset %q", path)
+	}
+
+	if d.HasChange("rotate") {
+		log.Printf("[DEBUG] Rotating GCP Secrets backend roleset %q", path)
+		if _, err := client.Logical().Write(path+"/rotate", nil); err != nil {
+			return fmt.Errorf("Error rotating GCP Secrets backend roleset %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated GCP Secrets backend roleset %q", path)
 	}
-	log.Printf("[DEBUG] Updated GCP Secrets backend roleset %q", path)
 
 	return gcpSecretRolesetRead(d, meta)
 }
@@ -31,6 +31,8 @@ func TestGCPSecretRoleset(t *testing.T) {
 	updatedRole := "roles/browser"
 	updatedConfig, updatedHash := testGCPSecretRoleset_access_token(backend, roleset, credentials, project, updatedRole)
 
+	rotateConfig := strings.Replace(updatedConfig, `secret_type = "access_token"`, "secret_type = \"access_token\"\n  rotate = true", 1)
+
 	keyConfig, keyHash := testGCPSecretRoleset_service_account_key(backend, roleset, credentials, project, updatedRole)
 
 	resource.Test(t, resource.TestCase{
@@ -80,6 +82,14 @@ func TestGCPSecretRoleset(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_gcp_secret_roleset.test", fmt.Sprintf("binding.%d.roles.2133424675", updatedHash), updatedRole),
 				),
 			},
+			{
+				Config: rotateConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testGCPSecretRoleset_attrs(backend, roleset),
+					testGCPSecretRoleset_serviceAccountEmail(&serviceAccountEmail, true),
+					resource.TestCheckResourceAttr("vault_gcp_secret_roleset.test", "rotate", "true"),
+				),
+			},
 			{
 				Config: keyConfig,
 				Check: resource.ComposeTestCheckFunc(
@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+// This test requires that the credentials passed belong to a service account which Vault can
+// manage keys for. The static account is bound to that same service account.
+func TestGCPSecretStaticAccount(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	staticAccount := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+
+	var creds struct {
+		ClientEmail string `json:"client_email"`
+	}
+	if err := json.Unmarshal([]byte(credentials), &creds); err != nil {
+		t.Fatalf("error decoding GOOGLE_CREDENTIALS: %s", err)
+	}
+	if creds.ClientEmail == "" {
+		t.Skip("GOOGLE_CREDENTIALS does not contain a client_email")
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testGCPSecretStaticAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPSecretStaticAccount_accessToken(backend, staticAccount, credentials, creds.ClientEmail, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "id", backend+"/static-account/"+staticAccount),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "static_account", staticAccount),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "secret_type", "access_token"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "service_account_email", creds.ClientEmail),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "service_account_project", project),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "token_scopes.#", "1"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "token_scopes.2400041053", "https://www.googleapis.com/auth/cloud-platform"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "binding.#", "0"),
+				),
+			},
+			{
+				ResourceName:            "vault_gcp_secret_static_account.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate"},
+			},
+			{
+				Config: testGCPSecretStaticAccount_accessToken(backend, staticAccount, credentials, creds.ClientEmail, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "rotate", "true"),
+					resource.TestCheckResourceAttr("vault_gcp_secret_static_account.test", "service_account_email", creds.ClientEmail),
+				),
+			},
+		},
+	})
+}
+
+func testGCPSecretStaticAccountDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcp_secret_static_account" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for GCP Secrets Static Account %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("GCP Secrets Static Account %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testGCPSecretStaticAccount_accessToken(backend, staticAccount, credentials, email string, rotate bool) string {
+	return fmt.Sprintf(`
+resource "vault_gcp_secret_backend" "test" {
+  path = "%s"
+  credentials = <<CREDS
+%s
+CREDS
+}
+
+resource "vault_gcp_secret_static_account" "test" {
+  backend = "${vault_gcp_secret_backend.test.path}"
+  static_account = "%s"
+  secret_type = "access_token"
+  token_scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+  service_account_email = "%s"
+  rotate = %t
+}
+`, backend, credentials, staticAccount, email, rotate)
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -58,6 +60,21 @@ func genericEndpointResource() *schema.Resource {
 				Default:     false,
 				Description: "Don't attempt to delete the path from Vault if true",
 			},
+			"delete_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      http.MethodDelete,
+				ValidateFunc: validation.StringInSlice([]string{http.MethodDelete, http.MethodPost}, false),
+				Description:  "HTTP method used to delete the path, either DELETE or POST",
+			},
+			"delete_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "JSON-encoded data to send when deleting the path",
+				StateFunc:    NormalizeDataJSON,
+				ValidateFunc: ValidateDataJSON,
+				Sensitive:    true,
+			},
 			"ignore_absent_fields": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -154,9 +171,34 @@ func genericEndpointResourceDelete(d *schema.ResourceData, meta interface{}) err
 		client := meta.(*api.Client)
 
 		path := d.Id()
+		method := d.Get("delete_method").(string)
+
+		var body map[string]interface{}
+		if v := d.Get("delete_body").(string); v != "" {
+			if err := json.Unmarshal([]byte(v), &body); err != nil {
+				return fmt.Errorf("delete_body %#v syntax error: %s", v, err)
+			}
+		}
 
-		log.Printf("[DEBUG] Deleting vault_generic_endpoint from %q", path)
-		_, err := client.Logical().Delete(path)
+		log.Printf("[DEBUG] Deleting vault_generic_endpoint from %q with %s", path, method)
+		if method == http.MethodDelete && body == nil {
+			_, err := client.Logical().Delete(path)
+			if err != nil {
+				return fmt.Errorf("error deleting %q from Vault: %q", path, err)
+			}
+			return nil
+		}
+
+		r := client.NewRequest(method, "/v1/"+path)
+		if body != nil {
+			if err := r.SetJSONBody(body); err != nil {
+				return fmt.Errorf("error encoding delete_body for %q: %s", path, err)
+			}
+		}
+		resp, err := client.RawRequest(r)
+		if resp != nil {
+			defer resp.Body.Close()
+		}
 		if err != nil {
 			return fmt.Errorf("error deleting %q from Vault: %q", path, err)
 		}
@@ -25,6 +25,62 @@ func TestResourceGenericEndpoint(t *testing.T) {
 	})
 }
 
+func TestResourceGenericEndpoint_deleteMethod(t *testing.T) {
+	path := acctest.RandomWithPrefix("userpass")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		CheckDestroy: func(s *terraform.State) error {
+			client := testProvider.Meta().(*api.Client)
+
+			resp, err := client.Logical().Read("auth/" + path + "/users/u1")
+			if err != nil {
+				return fmt.Errorf("error reading back user u1: %s", err)
+			}
+			if resp == nil {
+				return fmt.Errorf("user u1 was deleted instead of being reset")
+			}
+			if policies, ok := resp.Data["token_policies"].([]interface{}); !ok || len(policies) != 0 {
+				return fmt.Errorf("expected the token_policies of user u1 to be reset, got %#v", resp.Data["token_policies"])
+			}
+
+			if _, err := client.Logical().Delete("sys/auth/" + path); err != nil {
+				return fmt.Errorf("unable to delete auth/%s: %s", path, err)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if err := client.Sys().EnableAuthWithOptions(path, &api.EnableAuthOptions{Type: "userpass"}); err != nil {
+						t.Fatalf("unable to enable auth/%s: %s", path, err)
+					}
+				},
+				Config: fmt.Sprintf(`
+resource "vault_generic_endpoint" "u1" {
+  path                 = "auth/%s/users/u1"
+  ignore_absent_fields = true
+  delete_method        = "POST"
+  delete_body          = jsonencode({ token_policies = [] })
+
+  data_json = <<EOT
+{
+  "token_policies": ["p1"],
+  "password": "something"
+}
+EOT
+}
+`, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_endpoint.u1", "delete_method", "POST"),
+					resource.TestCheckResourceAttr("vault_generic_endpoint.u1", "delete_body", `{"token_policies":[]}`),
+				),
+			},
+		},
+	})
+}
+
 func testResourceGenericEndpoint_initialConfig(path string) string {
 	return fmt.Sprintf(`
 variable "up_path" {
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -61,6 +62,35 @@ func genericSecretResource() *schema.Resource {
 				Description: "Don't attempt to read the token from Vault if true; drift won't be detected.",
 			},
 
+			"read_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Top-level fields read from Vault to detect drift in data_json; other fields returned by Vault are only exposed in data.",
+			},
+
+			"delete_all_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only applicable for kv-v2 stores. If set, permanently deletes all versions for the specified key.",
+			},
+
+			"cas": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Only write the secret if its current version matches this value, 0 to only write it if it doesn't exist. Only supported on KV v2 mounts.",
+			},
+
+			"disable_check_and_set": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"cas"},
+				Description:   "Write the secret to a KV v2 mount that requires check-and-set without checking its version, by writing against the version that is current at the time of the write.",
+			},
+
 			"data": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -118,11 +148,32 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error determining if it's a v2 path: %s", err)
 	}
 
+	cas, casSet := d.GetOkExists("cas")
+	disableCheckAndSet := d.Get("disable_check_and_set").(bool)
+	if (casSet || disableCheckAndSet) && !v2 {
+		return fmt.Errorf("check-and-set is only supported on KV v2 mounts, %q is not on one", path)
+	}
+
 	if v2 {
+		options := map[string]interface{}{}
+		if disableCheckAndSet {
+			// Satisfy a check-and-set requirement of the mount or the
+			// secret without checking anything, by writing against the
+			// version that is current.
+			metadataPath := addPrefixToVKVPath(originalPath, mountPath, "metadata")
+			version, err := kvCurrentVersion(client, metadataPath)
+			if err != nil {
+				return fmt.Errorf("error reading the current version of %q for check-and-set: %s", originalPath, err)
+			}
+			cas, casSet = version, true
+		}
+		if casSet {
+			options["cas"] = cas
+		}
 		path = addPrefixToVKVPath(path, mountPath, "data")
 		data = map[string]interface{}{
 			"data":    data,
-			"options": map[string]interface{}{},
+			"options": options,
 		}
 
 	}
@@ -130,6 +181,9 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Writing generic Vault secret to %s", path)
 	_, err = client.Logical().Write(path, data)
 	if err != nil {
+		if v2 && !casSet && isCASRequiredError(err) {
+			return fmt.Errorf("error writing to Vault: %s; set cas or disable_check_and_set to write the secret", err)
+		}
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
@@ -149,7 +203,11 @@ func genericSecretResourceDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if v2 {
-		path = addPrefixToVKVPath(path, mountPath, "data")
+		if d.Get("delete_all_versions").(bool) {
+			path = addPrefixToVKVPath(path, mountPath, "metadata")
+		} else {
+			path = addPrefixToVKVPath(path, mountPath, "data")
+		}
 	}
 
 	log.Printf("[DEBUG] Deleting vault_generic_secret from %q", path)
@@ -190,7 +248,26 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] secret: %#v", secret)
 
 		data = secret.Data
-		jsonData, err := json.Marshal(secret.Data)
+		trackedData := secret.Data
+		if readFields := d.Get("read_fields").([]interface{}); len(readFields) > 0 {
+			// Only the read fields are compared against the configuration,
+			// everything else is taken from what was last written.
+			trackedData = map[string]interface{}{}
+			if v := d.Get("data_json").(string); v != "" {
+				if err := json.Unmarshal([]byte(v), &trackedData); err != nil {
+					return fmt.Errorf("data_json %#v syntax error: %s", v, err)
+				}
+			}
+			for _, f := range readFields {
+				if v, ok := secret.Data[f.(string)]; ok {
+					trackedData[f.(string)] = v
+				} else {
+					delete(trackedData, f.(string))
+				}
+			}
+		}
+
+		jsonData, err := json.Marshal(trackedData)
 		if err != nil {
 			return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
 		}
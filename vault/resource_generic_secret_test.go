@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -53,6 +54,168 @@ func TestResourceGenericSecret_deleted(t *testing.T) {
 	})
 }
 
+func TestResourceGenericSecret_casRequired(t *testing.T) {
+	mount := acctest.RandomWithPrefix("secretsv2")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_casMountConfig(mount),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					_, err := client.Logical().Write(mount+"/config", map[string]interface{}{
+						"cas_required": true,
+					})
+					if err != nil {
+						t.Fatalf("unable to require check-and-set on the mount: %s", err)
+					}
+				},
+				Config:      testResourceGenericSecret_casConfig(mount, "", "zap"),
+				ExpectError: regexp.MustCompile("check-and-set parameter required"),
+			},
+			{
+				Config: testResourceGenericSecret_casConfig(mount, "disable_check_and_set = true", "zap"),
+				Check:  resource.TestCheckResourceAttr("vault_generic_secret.test", "data.zip", "zap"),
+			},
+			{
+				Config: testResourceGenericSecret_casConfig(mount, "disable_check_and_set = true", "zoop"),
+				Check:  resource.TestCheckResourceAttr("vault_generic_secret.test", "data.zip", "zoop"),
+			},
+			{
+				Config:      testResourceGenericSecret_casConfig(mount, "cas = 1", "zeep"),
+				ExpectError: regexp.MustCompile("check-and-set parameter did not match"),
+			},
+			{
+				Config:      testResourceGenericSecret_casConfig(mount, "", "zeep"),
+				ExpectError: regexp.MustCompile("check-and-set parameter required"),
+			},
+			{
+				Config: testResourceGenericSecret_casConfig(mount, "cas = 2", "zeep"),
+				Check:  resource.TestCheckResourceAttr("vault_generic_secret.test", "data.zip", "zeep"),
+			},
+		},
+	})
+}
+
+func TestResourceGenericSecret_deleteAllVersions(t *testing.T) {
+	mount := acctest.RandomWithPrefix("secretsv2")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_casConfig(mount, "delete_all_versions = true", "zap"),
+				Check:  resource.TestCheckResourceAttr("vault_generic_secret.test", "delete_all_versions", "true"),
+			},
+			{
+				Config: testResourceGenericSecret_casMountConfig(mount),
+				Check: func(s *terraform.State) error {
+					client := testProvider.Meta().(*api.Client)
+					secret, err := client.Logical().Read(mount + "/metadata/foo")
+					if err != nil {
+						return fmt.Errorf("error reading back secret metadata: %s", err)
+					}
+					if secret != nil {
+						return fmt.Errorf("expected all versions of the secret to be deleted, found metadata %#v", secret.Data)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceGenericSecret_readFields(t *testing.T) {
+	path := acctest.RandomWithPrefix("secretsv1/test")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_readFieldsConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data.zip", "zap"),
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data.%", "1"),
+				),
+			},
+			{
+				// Fields outside of read_fields are exposed in data, but
+				// don't cause any drift.
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					_, err := client.Logical().Write(path, map[string]interface{}{
+						"zip":   "zap",
+						"extra": "value",
+					})
+					if err != nil {
+						t.Fatalf("unable to manually write the secret via the SDK: %s", err)
+					}
+				},
+				Config:   testResourceGenericSecret_readFieldsConfig(path),
+				PlanOnly: true,
+			},
+			{
+				Config: testResourceGenericSecret_readFieldsConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data.zip", "zap"),
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data.extra", "value"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceGenericSecret_readFieldsConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v1" {
+	path = "secretsv1"
+	type = "kv"
+	options = {
+		version = "1"
+	}
+}
+
+resource "vault_generic_secret" "test" {
+    depends_on  = ["vault_mount.v1"]
+    path        = "%s"
+    read_fields = ["zip"]
+    data_json   = <<EOT
+{
+    "zip": "zap"
+}
+EOT
+}`, path)
+}
+
+func testResourceGenericSecret_casMountConfig(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v2" {
+	path = "%s"
+	type = "kv"
+	options = {
+		version = "2"
+	}
+}
+`, mount)
+}
+
+func testResourceGenericSecret_casConfig(mount, extra, value string) string {
+	return testResourceGenericSecret_casMountConfig(mount) + fmt.Sprintf(`
+resource "vault_generic_secret" "test" {
+    path = "${vault_mount.v2.path}/foo"
+    %s
+    data_json = <<EOT
+{
+    "zip": %q
+}
+EOT
+}
+`, extra, value)
+}
+
 func testResourceGenericSecret_initialConfig(path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "v1" {
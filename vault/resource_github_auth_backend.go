@@ -26,6 +26,12 @@ func githubAuthBackendResource() *schema.Resource {
 			Required:    true,
 			Description: "The organization users must be part of.",
 		},
+		"organization_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the organization users must be part of. Vault will attempt to fetch and set this value if it is not provided.",
+		},
 		"base_url": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -74,10 +80,21 @@ func githubAuthBackendResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Schema: fields,
+		CustomizeDiff: githubAuthBackendDiff,
+		Schema:        fields,
 	}
 }
 
+// githubAuthBackendDiff marks organization_id as computed when the
+// organization changes without it, so that the ID of the previous
+// organization is reset and Vault fetches the one of the new organization.
+func githubAuthBackendDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("organization") || d.HasChange("organization_id") {
+		return nil
+	}
+	return d.SetNewComputed("organization_id")
+}
+
 func githubAuthBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	var description string
 
@@ -117,6 +134,12 @@ func githubAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("organization"); ok {
 		data["organization"] = v.(string)
 	}
+	// organization_id is computed by Vault when unset, so only send it
+	// when it has been changed in the configuration, or reset to 0 by
+	// githubAuthBackendDiff when the organization changed.
+	if d.HasChange("organization_id") {
+		data["organization_id"] = d.Get("organization_id").(int)
+	}
 	if v, ok := d.GetOk("base_url"); ok {
 		data["base_url"] = v.(string)
 	}
@@ -157,6 +180,7 @@ func githubAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Github auth config successfully written to '%q'", configPath)
 
 	d.SetPartial("organization")
+	d.SetPartial("organization_id")
 	d.SetPartial("base_url")
 	if _, ok := data["ttl"]; ok {
 		d.SetPartial("ttl")
@@ -266,6 +290,10 @@ func githubAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("path", d.Id())
 	d.Set("organization", dt.Data["organization"])
+	// Older versions of Vault don't return the organization ID.
+	if v, ok := dt.Data["organization_id"]; ok {
+		d.Set("organization_id", v)
+	}
 	d.Set("base_url", dt.Data["base_url"])
 	d.Set("description", authMount.Description)
 	d.Set("accessor", mount.Accessor)
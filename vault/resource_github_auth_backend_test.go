@@ -49,6 +49,61 @@ func TestAccGithubAuthBackend_basic(t *testing.T) {
 	})
 }
 
+func TestAccGithubAuthBackend_organizationID(t *testing.T) {
+	backend := acctest.RandomWithPrefix("github")
+	resName := "vault_github_auth_backend.gh"
+	var organizationID string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheckVaultVersion(t, "1.10.0") },
+		CheckDestroy: testAccCheckGithubAuthMountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubAuthBackendConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "organization", "vault"),
+					testAccGithubAuthBackendCheckOrganizationID(resName, func(v string) error {
+						if v == "" || v == "0" {
+							return fmt.Errorf("expected organization_id to be fetched by Vault, got %q", v)
+						}
+						organizationID = v
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccGithubAuthBackendConfig_updated(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "organization", "other_vault"),
+					testAccGithubAuthBackendCheckOrganizationID(resName, func(v string) error {
+						if v == "" || v == "0" || v == organizationID {
+							return fmt.Errorf("expected organization_id to be fetched again for the new organization, got %q", v)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccGithubAuthBackendConfig_organizationID(backend, "other_vault", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "organization", "other_vault"),
+					resource.TestCheckResourceAttr(resName, "organization_id", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGithubAuthBackendCheckOrganizationID(resName string, check func(string) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resName)
+		}
+		return check(rs.Primary.Attributes["organization_id"])
+	}
+}
+
 func TestAccGithubAuthBackend_tuning(t *testing.T) {
 	backend := acctest.RandomWithPrefix("github")
 	resName := "vault_github_auth_backend.gh"
@@ -242,6 +297,16 @@ resource "vault_github_auth_backend" "gh" {
 `, backend)
 }
 
+func testAccGithubAuthBackendConfig_organizationID(backend, organization string, organizationID int) string {
+	return fmt.Sprintf(`
+resource "vault_github_auth_backend" "gh" {
+  path = "%s"
+  organization = "%s"
+  organization_id = %d
+}
+`, backend, organization, organizationID)
+}
+
 func testAccGithubAuthBackendConfig_tuning(backend string) string {
 	return fmt.Sprintf(`
 resource "vault_github_auth_backend" "gh" {
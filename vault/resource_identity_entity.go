@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -63,6 +64,13 @@ func identityEntityResource() *schema.Resource {
 				Optional:    true,
 				Description: "Whether the entity is disabled. Disabled entities' associated tokens cannot be used, but are not revoked.",
 			},
+
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Adopt an existing entity with the same name instead of failing on create.",
+			},
 		},
 	}
 }
@@ -118,6 +126,22 @@ func identityEntityCreate(d *schema.ResourceData, meta interface{}) error {
 
 	resp, err := client.Logical().Write(path, data)
 
+	// Depending on its version Vault either rejects an entity whose name is
+	// already in use, or returns no response for it. Any other error is
+	// returned as is.
+	conflict := (err == nil && resp == nil) || (err != nil && isIdentityEntityNameInUseErr(err))
+	if conflict && d.Get("adopt_existing").(bool) {
+		entity, lookupErr := readIdentityEntityByName(client, name)
+		if lookupErr != nil {
+			return fmt.Errorf("error adopting existing IdentityEntity %q: %s", name, lookupErr)
+		}
+		if entity != nil {
+			log.Printf("[DEBUG] Adopting existing IdentityEntity %q", name)
+			d.SetId(entity.Data["id"].(string))
+			return identityEntityUpdate(d, meta)
+		}
+	}
+
 	if err != nil {
 		return fmt.Errorf("error writing IdentityEntity to %q: %s", name, err)
 	}
@@ -177,6 +201,19 @@ func identityEntityRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error reading IdentityEntity %q: %s", id, err)
 	}
 	log.Printf("[DEBUG] Read IdentityEntity %s", id)
+	if resp == nil && d.Get("adopt_existing").(bool) {
+		// the entity may have been recreated out of band, look it up by name
+		// before giving up on it.
+		resp, err = readIdentityEntityByName(client, d.Get("name").(string))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			id = resp.Data["id"].(string)
+			log.Printf("[DEBUG] Adopting IdentityEntity %q found by name", id)
+			d.SetId(id)
+		}
+	}
 	if resp == nil {
 		log.Printf("[WARN] IdentityEntity %q not found, removing from state", id)
 		d.SetId("")
@@ -233,6 +270,10 @@ func identityEntityExists(d *schema.ResourceData, meta interface{}) (bool, error
 	return resp != nil, nil
 }
 
+func isIdentityEntityNameInUseErr(err error) bool {
+	return strings.Contains(err.Error(), "Code: 400") && strings.Contains(err.Error(), "entity name is already in use")
+}
+
 func identityEntityNamePath(name string) string {
 	return fmt.Sprintf("%s/name/%s", identityEntityPath, name)
 }
@@ -267,3 +308,19 @@ func readIdentityEntity(client *api.Client, entityID string) (*api.Secret, error
 	}
 	return resp, nil
 }
+
+// May return nil if entity does not exist
+func readIdentityEntityByName(client *api.Client, name string) (*api.Secret, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	path := identityEntityNamePath(name)
+	log.Printf("[DEBUG] Reading Entity %s from %q", name, path)
+
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return resp, fmt.Errorf("failed reading IdentityEntity %s from %s: %s", name, path, err)
+	}
+	return resp, nil
+}
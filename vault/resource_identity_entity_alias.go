@@ -31,7 +31,7 @@ func identityEntityAliasResource() *schema.Resource {
 			"mount_accessor": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Mount accessor to which this alias belongs toMount accessor to which this alias belongs to.",
+				Description: "Mount accessor to which this alias belongs to.",
 			},
 
 			"canonical_id": {
@@ -39,6 +39,15 @@ func identityEntityAliasResource() *schema.Resource {
 				Required:    true,
 				Description: "ID of the entity to which this is an alias.",
 			},
+
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata to be associated with this alias.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -52,12 +61,20 @@ func identityEntityAliasCreate(d *schema.ResourceData, meta interface{}) error {
 
 	path := identityEntityAliasPath
 
+	if err := identityEntityAliasCheckMountAccessor(client, mountAccessor); err != nil {
+		return err
+	}
+
 	data := map[string]interface{}{
 		"name":           name,
 		"mount_accessor": mountAccessor,
 		"canonical_id":   canonicalID,
 	}
 
+	if v, ok := d.GetOk("custom_metadata"); ok {
+		data["custom_metadata"] = v
+	}
+
 	resp, err := client.Logical().Write(path, data)
 
 	if err != nil {
@@ -92,6 +109,9 @@ func identityEntityAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error updating IdentityEntityAlias %q: %s", id, err)
 	}
+	if resp == nil {
+		return fmt.Errorf("error updating IdentityEntityAlias %q: alias not found", id)
+	}
 
 	data := map[string]interface{}{
 		"name":           resp.Data["name"],
@@ -103,11 +123,19 @@ func identityEntityAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["name"] = name
 	}
 	if mountAccessor, ok := d.GetOk("mount_accessor"); ok {
+		if d.HasChange("mount_accessor") {
+			if err := identityEntityAliasCheckMountAccessor(client, mountAccessor.(string)); err != nil {
+				return err
+			}
+		}
 		data["mount_accessor"] = mountAccessor
 	}
 	if canonicalID, ok := d.GetOk("canonical_id"); ok {
 		data["canonical_id"] = canonicalID
 	}
+	if d.HasChange("custom_metadata") {
+		data["custom_metadata"] = d.Get("custom_metadata")
+	}
 
 	_, err = client.Logical().Write(path, data)
 
@@ -143,6 +171,12 @@ func identityEntityAliasRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error setting state key \"%s\" on IdentityEntityAlias %q: %s", k, id, err)
 		}
 	}
+	// Vault versions prior to 1.9 do not support custom metadata.
+	if v, ok := resp.Data["custom_metadata"]; ok {
+		if err := d.Set("custom_metadata", v); err != nil {
+			return fmt.Errorf("error setting state key \"custom_metadata\" on IdentityEntityAlias %q: %s", id, err)
+		}
+	}
 	return nil
 }
 
@@ -193,6 +227,26 @@ func identityEntityAliasIDPath(id string) string {
 	return fmt.Sprintf("%s/id/%s", identityEntityAliasPath, id)
 }
 
+// identityEntityAliasCheckMountAccessor ensures that accessor refers to an
+// existing auth mount, so that stale accessors fail early with a clear error
+// rather than a 400 from the identity store. The check is skipped if the
+// token is not allowed to list auth mounts.
+func identityEntityAliasCheckMountAccessor(client *api.Client, accessor string) error {
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		log.Printf("[WARN] Unable to list auth mounts, skipping mount_accessor validation: %s", err)
+		return nil
+	}
+
+	for _, auth := range auths {
+		if auth.Accessor == accessor {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("mount_accessor %q does not match any enabled auth mount", accessor)
+}
+
 func findAliasID(client *api.Client, canonicalID, name, mountAccessor string) (string, error) {
 	path := identityEntityIDPath(canonicalID)
 
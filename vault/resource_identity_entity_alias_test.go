@@ -39,6 +39,60 @@ func TestAccIdentityEntityAlias(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityAlias_customMetadata(t *testing.T) {
+	entity := acctest.RandomWithPrefix("my-entity")
+
+	nameEntityAlias := "vault_identity_entity_alias.entity-alias"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityAliasConfigCustomMetadata(entity, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(nameEntityAlias, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(nameEntityAlias, "custom_metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccIdentityEntityAliasConfigCustomMetadata(entity, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(nameEntityAlias, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(nameEntityAlias, "custom_metadata.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityEntityAlias_invalidMountAccessor(t *testing.T) {
+	entity := acctest.RandomWithPrefix("my-entity")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+}
+
+resource "vault_identity_entity_alias" "entity-alias" {
+  name           = "${vault_identity_entity.entity.name}"
+  mount_accessor = "auth_github_doesnotexist"
+  canonical_id   = "${vault_identity_entity.entity.id}"
+}
+`, entity),
+				ExpectError: regexp.MustCompile(`mount_accessor "auth_github_doesnotexist" does not match any enabled auth mount`),
+			},
+		},
+	})
+}
+
 func TestAccIdentityEntityAlias_Update(t *testing.T) {
 	entity := acctest.RandomWithPrefix("my-entity")
 
@@ -139,3 +193,25 @@ resource "vault_identity_entity_alias" "entity-alias-dupe" {
 
 	return ret
 }
+
+func testAccIdentityEntityAliasConfigCustomMetadata(entityName, value string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+}
+
+resource "vault_auth_backend" "github" {
+  type = "github"
+  path = "github-%s"
+}
+
+resource "vault_identity_entity_alias" "entity-alias" {
+  name           = "${vault_identity_entity.entity.name}"
+  mount_accessor = "${vault_auth_backend.github.accessor}"
+  canonical_id   = "${vault_identity_entity.entity.id}"
+  custom_metadata = {
+    foo = "%s"
+  }
+}
+`, entityName, entityName, value)
+}
@@ -3,12 +3,15 @@ package vault
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/hashicorp/vault/api"
 )
@@ -107,6 +110,158 @@ func TestAccIdentityEntityUpdateRemovePolicies(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityAdoptExisting(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					_, err := client.Logical().Write(identityEntityPath, map[string]interface{}{
+						"name": entity,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIdentityEntityConfigAdoptExisting(entity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "name", entity),
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "adopt_existing", "true"),
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "metadata.version", "1"),
+					testAccIdentityEntityStoreID("vault_identity_entity.entity", &id),
+				),
+			},
+			{
+				// The entity is renamed outside of Terraform, it is still
+				// found by its ID and renamed back.
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					_, err := client.Logical().Write(identityEntityIDPath(id), map[string]interface{}{
+						"name": entity + "-renamed",
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIdentityEntityConfigAdoptExisting(entity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "name", entity),
+					resource.TestCheckResourceAttrPtr("vault_identity_entity.entity", "id", &id),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityEntityStoreID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", n)
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func TestIdentityEntityCreate_adoptExisting(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		body           string
+		expectedError  string
+		expectedID     string
+		expectedLookup bool
+	}{
+		{
+			name:           "name-in-use",
+			status:         http.StatusBadRequest,
+			body:           `{"errors":["entity name is already in use"]}`,
+			expectedID:     "existing",
+			expectedLookup: true,
+		},
+		{
+			name:           "no-response",
+			status:         http.StatusNoContent,
+			expectedID:     "existing",
+			expectedLookup: true,
+		},
+		{
+			name:          "permission-denied",
+			status:        http.StatusForbidden,
+			body:          `{"errors":["permission denied"]}`,
+			expectedError: "permission denied",
+		},
+		{
+			name:          "other-bad-request",
+			status:        http.StatusBadRequest,
+			body:          `{"errors":["invalid metadata"]}`,
+			expectedError: "invalid metadata",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookup := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/v1/identity/entity":
+					w.WriteHeader(tt.status)
+					fmt.Fprint(w, tt.body)
+				case r.URL.Path == "/v1/identity/entity/name/foo":
+					lookup = true
+					fmt.Fprint(w, `{"data":{"id":"existing","name":"foo"}}`)
+				case r.URL.Path == "/v1/identity/entity/id/existing" && r.Method == http.MethodGet:
+					fmt.Fprint(w, `{"data":{"id":"existing","name":"foo","disabled":false}}`)
+				case r.URL.Path == "/v1/identity/entity/id/existing":
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d := schema.TestResourceDataRaw(t, identityEntityResource().Schema, map[string]interface{}{
+				"name":           "foo",
+				"adopt_existing": true,
+			})
+
+			err = identityEntityCreate(d, client)
+			if tt.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedError) {
+					t.Fatalf("expected an error containing %q, got %v", tt.expectedError, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if lookup != tt.expectedLookup {
+				t.Fatalf("expected lookup by name %t, got %t", tt.expectedLookup, lookup)
+			}
+			if d.Id() != tt.expectedID {
+				t.Fatalf("expected id %q, got %q", tt.expectedID, d.Id())
+			}
+		})
+	}
+}
+
 func testAccCheckIdentityEntityDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -231,6 +386,18 @@ resource "vault_identity_entity" "entity" {
 }`, entityName)
 }
 
+func testAccIdentityEntityConfigAdoptExisting(entityName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+  policies = ["test"]
+  metadata = {
+    version = "1"
+  }
+  adopt_existing = true
+}`, entityName)
+}
+
 func testAccIdentityEntityConfigUpdate(entityName string) string {
 	return fmt.Sprintf(`
 resource "vault_identity_entity" "entity" {
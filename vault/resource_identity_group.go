@@ -3,13 +3,18 @@ package vault
 import (
 	"fmt"
 	"log"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
-const identityGroupPath = "/identity/group"
+const (
+	identityGroupPath = "/identity/group"
+
+	identityGroupUpdateMaxAttempts = 5
+)
 
 func identityGroupResource() *schema.Resource {
 	return &schema.Resource{
@@ -76,13 +81,20 @@ func identityGroupResource() *schema.Resource {
 				// Suppress the diff if group type is "external" because we cannot manage
 				// group members
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if d.Get("type").(string) == "external" {
+					if d.Get("type").(string) == "external" || d.Get("external_member_group_ids").(bool) == true {
 						return true
 					}
 					return false
 				},
 			},
 
+			"external_member_group_ids": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage member groups externally through `vault_identity_group_member_group_ids`",
+			},
+
 			"member_entity_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -106,6 +118,12 @@ func identityGroupResource() *schema.Resource {
 				Default:     false,
 				Description: "Manage member entities externally through `vault_identity_group_policies_member_entity_ids`",
 			},
+
+			"modification_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the group was last modified, used to detect changes made outside of Terraform before updating it.",
+			},
 		},
 	}
 }
@@ -122,7 +140,9 @@ func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface
 
 		// Member groups and entities can't be set for external groups
 		if d.Get("type").(string) == "internal" {
-			data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+			if externalMemberGroupIds, ok := d.GetOk("external_member_group_ids"); !(ok && externalMemberGroupIds.(bool)) {
+				data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+			}
 
 			if externalMemberEntityIds, ok := d.GetOk("external_member_entity_ids"); !(ok && externalMemberEntityIds.(bool)) {
 				data["member_entity_ids"] = d.Get("member_entity_ids").(*schema.Set).List()
@@ -133,25 +153,80 @@ func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface
 			data["metadata"] = metadata
 		}
 	} else {
-		if d.HasChanges("name", "external_policies", "policies", "metadata", "member_entity_ids", "member_group_ids") {
+		// Only changed fields are written, so that concurrent changes to the
+		// other ones are not clobbered.
+		if d.HasChange("name") {
 			data["name"] = d.Get("name")
+		}
+		if d.HasChange("metadata") {
 			data["metadata"] = d.Get("metadata")
-			data["policies"] = d.Get("policies").(*schema.Set).List()
-			data["member_entity_ids"] = d.Get("member_entity_ids").(*schema.Set).List()
-			data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+		}
+		for _, k := range identityGroupManagedSetFields(d) {
+			if d.HasChange(k) {
+				data[k] = d.Get(k).(*schema.Set).List()
+			}
+		}
+	}
 
+	return nil
+}
 
-			// Edge case where if external_policies is true, no policies
-			// should be configured on the entity.
-			data["external_policies"] = d.Get("external_policies").(bool)
-			if data["external_policies"].(bool) {
-				data["policies"] = nil
-			}
-			// if external_member_entity_ids is true, member_entity_ids will be nil
-			data["external_member_entity_ids"] = d.Get("external_member_entity_ids").(bool)
-			if data["external_member_entity_ids"].(bool) {
-				data["member_entity_ids"] = nil
-			}
+// identityGroupManagedSetFields returns the set fields that are managed by the
+// resource rather than externally or by Vault itself.
+func identityGroupManagedSetFields(d *schema.ResourceData) []string {
+	var fields []string
+	if !d.Get("external_policies").(bool) {
+		fields = append(fields, "policies")
+	}
+
+	// Member groups and entities can't be set for external groups
+	if d.Get("type").(string) == "internal" {
+		if !d.Get("external_member_entity_ids").(bool) {
+			fields = append(fields, "member_entity_ids")
+		}
+		if !d.Get("external_member_group_ids").(bool) {
+			fields = append(fields, "member_group_ids")
+		}
+	}
+
+	return fields
+}
+
+// identityGroupCheckConflicts returns an error if any of the fields about to
+// be written was changed in Vault since the group was last read, as writing it
+// would silently discard that change.
+func identityGroupCheckConflicts(d *schema.ResourceData, resp *api.Secret) error {
+	conflict := func(k string) error {
+		return fmt.Errorf("%s of IdentityGroup %q was modified outside of Terraform since it was last read, refresh the state and try again", k, d.Id())
+	}
+
+	if d.HasChange("name") {
+		if old, _ := d.GetChange("name"); old != resp.Data["name"] {
+			return conflict("name")
+		}
+	}
+	if d.HasChange("metadata") {
+		current := map[string]interface{}{}
+		if v, ok := resp.Data["metadata"].(map[string]interface{}); ok {
+			current = v
+		}
+		if old, _ := d.GetChange("metadata"); !reflect.DeepEqual(old, current) {
+			return conflict("metadata")
+		}
+	}
+
+	for _, k := range identityGroupManagedSetFields(d) {
+		if !d.HasChange(k) {
+			continue
+		}
+
+		var current []interface{}
+		if v, ok := resp.Data[k]; ok && v != nil {
+			current = v.([]interface{})
+		}
+		old, _ := d.GetChange(k)
+		if !old.(*schema.Set).Equal(schema.NewSet(schema.HashString, current)) {
+			return conflict(k)
 		}
 	}
 
@@ -201,15 +276,84 @@ func identityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err := identityGroupUpdateFields(d, data, false); err != nil {
 		return fmt.Errorf("error updating IdentityGroup %q: %s", id, err)
 	}
+	if len(data) == 0 {
+		return identityGroupRead(d, meta)
+	}
 
-	_, err := client.Logical().Write(path, data)
+	// Vault has no check-and-set for groups. If the group was modified since
+	// it was last read, only go ahead if none of the fields about to be
+	// written were part of that change. The written fields are read back, and
+	// the write is retried if a concurrent write discarded them.
+	for attempt := 1; attempt <= identityGroupUpdateMaxAttempts; attempt++ {
+		resp, err := readIdentityGroup(client, id)
+		if err != nil {
+			return fmt.Errorf("error updating IdentityGroup %q: %s", id, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("error updating IdentityGroup %q: group does not exist", id)
+		}
 
-	if err != nil {
-		return fmt.Errorf("error updating IdentityGroup %q: %s", id, err)
+		if resp.Data["modification_time"] != d.Get("modification_time") {
+			log.Printf("[DEBUG] IdentityGroup %q was modified since it was last read", id)
+			if err := identityGroupCheckConflicts(d, resp); err != nil {
+				return err
+			}
+		}
+
+		data = map[string]interface{}{}
+		if err := identityGroupUpdateFields(d, data, false); err != nil {
+			return fmt.Errorf("error updating IdentityGroup %q: %s", id, err)
+		}
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error updating IdentityGroup %q: %s", id, err)
+		}
+
+		latest, err := readIdentityGroup(client, id)
+		if err != nil {
+			return fmt.Errorf("error updating IdentityGroup %q: %s", id, err)
+		}
+		if latest == nil {
+			return fmt.Errorf("error updating IdentityGroup %q: group does not exist", id)
+		}
+		if identityGroupWritten(data, latest) {
+			log.Printf("[DEBUG] Updated IdentityGroup %q", id)
+			return identityGroupRead(d, meta)
+		}
+		log.Printf("[DEBUG] IdentityGroup %q was modified while updating it, retrying (attempt %d)", id, attempt)
 	}
-	log.Printf("[DEBUG] Updated IdentityGroup %q", id)
 
-	return identityGroupRead(d, meta)
+	return fmt.Errorf("error updating IdentityGroup %q: group was modified concurrently %d times", id, identityGroupUpdateMaxAttempts)
+}
+
+// identityGroupWritten reports whether the group in resp holds the fields in
+// data.
+func identityGroupWritten(data map[string]interface{}, resp *api.Secret) bool {
+	for k, v := range data {
+		switch want := v.(type) {
+		case []interface{}:
+			var current []interface{}
+			if v, ok := resp.Data[k].([]interface{}); ok {
+				current = v
+			}
+			if !schema.NewSet(schema.HashString, current).Equal(schema.NewSet(schema.HashString, want)) {
+				return false
+			}
+		case map[string]interface{}:
+			current := map[string]interface{}{}
+			if v, ok := resp.Data[k].(map[string]interface{}); ok {
+				current = v
+			}
+			if !reflect.DeepEqual(current, want) {
+				return false
+			}
+		default:
+			if resp.Data[k] != want {
+				return false
+			}
+		}
+	}
+
+	return true
 }
 
 func identityGroupRead(d *schema.ResourceData, meta interface{}) error {
@@ -231,7 +375,7 @@ func identityGroupRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	readFields := []string{"name", "type", "metadata", "member_entity_ids", "member_group_ids", "policies"}
+	readFields := []string{"name", "type", "metadata", "member_entity_ids", "member_group_ids", "policies", "modification_time"}
 
 	for _, k := range readFields {
 		if err := d.Set(k, resp.Data[k]); err != nil {
@@ -314,6 +458,80 @@ func readIdentityGroupMemberEntityIds(client *api.Client, groupID string) ([]int
 	return make([]interface{}, 0), nil
 }
 
+// identityGroupMembersConfig describes the group members handled by
+// identityGroupMembersWrite.
+type identityGroupMembersConfig struct {
+	// resourceName is used in error messages.
+	resourceName string
+	// field is the group field holding the members.
+	field string
+	// description is a human readable name for the members.
+	description string
+}
+
+// identityGroupMembersWrite writes the members computed by members from the
+// group's current ones. Vault has no check-and-set for groups, so the members
+// are read back after writing; if a concurrent write discarded them, they are
+// computed again from the group's current members and written again. Missing
+// and external groups are an error when mustExist is set and are skipped
+// otherwise.
+func identityGroupMembersWrite(client *api.Client, config identityGroupMembersConfig, id string, mustExist bool, members func([]interface{}) []interface{}) error {
+	path := identityGroupIDPath(id)
+
+	for attempt := 1; attempt <= identityGroupUpdateMaxAttempts; attempt++ {
+		resp, err := readIdentityGroup(client, id)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			if mustExist {
+				return fmt.Errorf("error IdentityGroup %s does not exist", id)
+			}
+			return nil
+		}
+
+		// Members of external groups are managed by Vault through group
+		// aliases and cannot be set.
+		if t, ok := resp.Data["type"]; ok && t == "external" {
+			if mustExist {
+				return fmt.Errorf("error updating %s %q: %s cannot be set on external groups", config.resourceName, id, config.description)
+			}
+			return nil
+		}
+
+		apiMembers := make([]interface{}, 0)
+		if v, ok := resp.Data[config.field]; ok && v != nil {
+			apiMembers = v.([]interface{})
+		}
+		want := members(apiMembers)
+		data := map[string]interface{}{
+			config.field: want,
+		}
+
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error updating %s %q: %s", config.resourceName, id, err)
+		}
+
+		latest, err := readIdentityGroup(client, id)
+		if err != nil {
+			return err
+		}
+		if latest == nil {
+			return fmt.Errorf("error IdentityGroup %s does not exist", id)
+		}
+		var current []interface{}
+		if v, ok := latest.Data[config.field]; ok && v != nil {
+			current = v.([]interface{})
+		}
+		if schema.NewSet(schema.HashString, current).Equal(schema.NewSet(schema.HashString, want)) {
+			return nil
+		}
+		log.Printf("[DEBUG] IdentityGroup %q was modified while updating its %s, retrying (attempt %d)", id, config.description, attempt)
+	}
+
+	return fmt.Errorf("error updating %s %q: group was modified concurrently %d times", config.resourceName, id, identityGroupUpdateMaxAttempts)
+}
+
 // This function may return `nil` for the IdentityGroup if it does not exist
 func readIdentityGroup(client *api.Client, groupID string) (*api.Secret, error) {
 	path := identityGroupIDPath(groupID)
@@ -58,40 +58,27 @@ func identityGroupMemberEntityIdsUpdate(d *schema.ResourceData, meta interface{}
 	vaultMutexKV.Lock(path)
 	defer vaultMutexKV.Unlock(path)
 
-	data := make(map[string]interface{})
 	memberEntityIds := d.Get("member_entity_ids").(*schema.Set).List()
 
-	resp, err := readIdentityGroup(client, id)
-	if err != nil {
-		return err
-	}
-
-	t, ok := resp.Data["type"]
-	if ok && t != "external" {
+	err := identityGroupMemberEntityIdsWrite(client, id, true, func(apiMemberEntityIds []interface{}) []interface{} {
 		if d.Get("exclusive").(bool) {
-			data["member_entity_ids"] = memberEntityIds
-		} else {
-			apiMemberEntityIds, err := readIdentityGroupMemberEntityIds(client, id)
-			if err != nil {
-				return err
-			}
-			if d.HasChange("member_entity_ids") {
-				oldMemberEntityIdsI, _ := d.GetChange("member_entity_ids")
-				oldMemberEntityIds := oldMemberEntityIdsI.(*schema.Set).List()
-				for _, memberEntityId := range oldMemberEntityIds {
-					apiMemberEntityIds = util.SliceRemoveIfPresent(apiMemberEntityIds, memberEntityId)
-				}
-			}
-			for _, memberEntityId := range memberEntityIds {
-				apiMemberEntityIds = util.SliceAppendIfMissing(apiMemberEntityIds, memberEntityId)
-			}
-			data["member_entity_ids"] = apiMemberEntityIds
+			return memberEntityIds
 		}
-	}
 
-	_, err = client.Logical().Write(path, data)
+		if d.HasChange("member_entity_ids") {
+			oldMemberEntityIdsI, _ := d.GetChange("member_entity_ids")
+			oldMemberEntityIds := oldMemberEntityIdsI.(*schema.Set).List()
+			for _, memberEntityId := range oldMemberEntityIds {
+				apiMemberEntityIds = util.SliceRemoveIfPresent(apiMemberEntityIds, memberEntityId)
+			}
+		}
+		for _, memberEntityId := range memberEntityIds {
+			apiMemberEntityIds = util.SliceAppendIfMissing(apiMemberEntityIds, memberEntityId)
+		}
+		return apiMemberEntityIds
+	})
 	if err != nil {
-		return fmt.Errorf("error updating IdentityGroupMemberEntityIds %q: %s", id, err)
+		return err
 	}
 	log.Printf("[DEBUG] Updated IdentityGroupMemberEntityIds %q", id)
 
@@ -100,6 +87,16 @@ func identityGroupMemberEntityIdsUpdate(d *schema.ResourceData, meta interface{}
 	return identityGroupMemberEntityIdsRead(d, meta)
 }
 
+// identityGroupMemberEntityIdsWrite writes the member entity IDs computed by
+// members from the group's current ones, see identityGroupMembersWrite.
+func identityGroupMemberEntityIdsWrite(client *api.Client, id string, mustExist bool, members func([]interface{}) []interface{}) error {
+	return identityGroupMembersWrite(client, identityGroupMembersConfig{
+		resourceName: "IdentityGroupMemberEntityIds",
+		field:        "member_entity_ids",
+		description:  "member entities",
+	}, id, mustExist, members)
+}
+
 func identityGroupMemberEntityIdsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	id := d.Id()
@@ -126,7 +123,10 @@ func identityGroupMemberEntityIdsRead(d *schema.ResourceData, meta interface{})
 	} else {
 		userMemberEntityIds := d.Get("member_entity_ids").(*schema.Set).List()
 		newMemberEntityIds := make([]string, 0)
-		apiMemberEntityIds := resp.Data["member_entity_ids"].([]interface{})
+		apiMemberEntityIds := make([]interface{}, 0)
+		if v, ok := resp.Data["member_entity_ids"]; ok && v != nil {
+			apiMemberEntityIds = v.([]interface{})
+		}
 
 		for _, memberEntityId := range userMemberEntityIds {
 			if found, _ := util.SliceHasElement(apiMemberEntityIds, memberEntityId); found {
@@ -150,34 +150,20 @@ func identityGroupMemberEntityIdsDelete(d *schema.ResourceData, meta interface{}
 	vaultMutexKV.Lock(path)
 	defer vaultMutexKV.Unlock(path)
 
-	data := make(map[string]interface{})
-
-	resp, err := readIdentityGroup(client, id)
-	if err != nil {
-		return err
-	}
-
-	t, ok := resp.Data["type"]
-	if ok && t != "external" {
+	err := identityGroupMemberEntityIdsWrite(client, id, false, func(apiMemberEntityIds []interface{}) []interface{} {
 		if d.Get("exclusive").(bool) {
-			data["member_entity_ids"] = make([]string, 0)
-		} else {
-			apiMemberEntityIds, err := readIdentityGroupMemberEntityIds(client, id)
-			if err != nil {
-				return err
-			}
-			for _, memberEntityId := range d.Get("member_entity_ids").(*schema.Set).List() {
-				apiMemberEntityIds = util.SliceRemoveIfPresent(apiMemberEntityIds, memberEntityId)
-			}
-			data["member_entity_ids"] = apiMemberEntityIds
+			return make([]interface{}, 0)
 		}
-	}
 
-	_, err = client.Logical().Write(path, data)
+		for _, memberEntityId := range d.Get("member_entity_ids").(*schema.Set).List() {
+			apiMemberEntityIds = util.SliceRemoveIfPresent(apiMemberEntityIds, memberEntityId)
+		}
+		return apiMemberEntityIds
+	})
 	if err != nil {
-		return fmt.Errorf("error updating IdentityGroupMemberEntityIds %q: %s", id, err)
+		return err
 	}
-	log.Printf("[DEBUG] Updated IdentityGroupMemberEntityIds %q", id)
+	log.Printf("[DEBUG] Deleted IdentityGroupMemberEntityIds %q", id)
 
 	return nil
 }
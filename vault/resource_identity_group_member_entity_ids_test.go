@@ -3,6 +3,7 @@ package vault
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -207,6 +208,22 @@ func (tester *memberEntityTester) CheckNoMemberEntity(resourceString string) res
 	}
 }
 
+func TestAccIdentityGroupMemberEntityIdsExternalGroup(t *testing.T) {
+	devEntity := acctest.RandomWithPrefix("dev-entity")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckidentityGroupMemberEntityIdsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityGroupMemberEntityIdsConfigExternalGroup(devEntity),
+				ExpectError: regexp.MustCompile("member entities cannot be set on external groups"),
+			},
+		},
+	})
+}
+
 func testAccCheckidentityGroupMemberEntityIdsDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -402,6 +419,23 @@ resource "vault_identity_group_member_entity_ids" "member_entity_ids" {
 }`
 }
 
+func testAccIdentityGroupMemberEntityIdsConfigExternalGroup(devEntityName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+	type = "external"
+}
+
+resource "vault_identity_entity" "dev" {
+	name = "%s"
+}
+
+resource "vault_identity_group_member_entity_ids" "member_entity_ids" {
+  group_id = vault_identity_group.group.id
+  member_entity_ids = ["${vault_identity_entity.dev.id}"]
+  exclusive = false
+}`, devEntityName)
+}
+
 func testAccIdentityGroupMemberEntityIdsConfigExclusive(devEntityName string) string {
 	return fmt.Sprintf(`
 resource "vault_identity_group" "group" {
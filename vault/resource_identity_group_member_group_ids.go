@@ -0,0 +1,169 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func identityGroupMemberGroupIdsResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityGroupMemberGroupIdsUpdate,
+		Update: identityGroupMemberGroupIdsUpdate,
+		Read:   identityGroupMemberGroupIdsRead,
+		Delete: identityGroupMemberGroupIdsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"member_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Group IDs to be assigned as group members.",
+			},
+
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Should the resource manage member groups exclusively? Beware of race conditions when disabling exclusive management",
+			},
+
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the group.",
+			},
+
+			"group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the group.",
+			},
+		},
+	}
+}
+
+func identityGroupMemberGroupIdsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Get("group_id").(string)
+
+	log.Printf("[DEBUG] Updating IdentityGroupMemberGroupIds %q", id)
+	path := identityGroupIDPath(id)
+
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	memberGroupIds := d.Get("member_group_ids").(*schema.Set).List()
+
+	err := identityGroupMemberGroupIdsWrite(client, id, true, func(apiMemberGroupIds []interface{}) []interface{} {
+		if d.Get("exclusive").(bool) {
+			return memberGroupIds
+		}
+
+		if d.HasChange("member_group_ids") {
+			oldMemberGroupIdsI, _ := d.GetChange("member_group_ids")
+			oldMemberGroupIds := oldMemberGroupIdsI.(*schema.Set).List()
+			for _, memberGroupId := range oldMemberGroupIds {
+				apiMemberGroupIds = util.SliceRemoveIfPresent(apiMemberGroupIds, memberGroupId)
+			}
+		}
+		for _, memberGroupId := range memberGroupIds {
+			apiMemberGroupIds = util.SliceAppendIfMissing(apiMemberGroupIds, memberGroupId)
+		}
+		return apiMemberGroupIds
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Updated IdentityGroupMemberGroupIds %q", id)
+
+	d.SetId(id)
+
+	return identityGroupMemberGroupIdsRead(d, meta)
+}
+
+// identityGroupMemberGroupIdsWrite writes the member group IDs computed by
+// members from the group's current ones, see identityGroupMembersWrite.
+func identityGroupMemberGroupIdsWrite(client *api.Client, id string, mustExist bool, members func([]interface{}) []interface{}) error {
+	return identityGroupMembersWrite(client, identityGroupMembersConfig{
+		resourceName: "IdentityGroupMemberGroupIds",
+		field:        "member_group_ids",
+		description:  "member groups",
+	}, id, mustExist, members)
+}
+
+func identityGroupMemberGroupIdsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	resp, err := readIdentityGroup(client, id)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Read IdentityGroupMemberGroupIds %s", id)
+	if resp == nil {
+		log.Printf("[WARN] IdentityGroupMemberGroupIds %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_id", id)
+	d.Set("group_name", resp.Data["name"])
+
+	if d.Get("exclusive").(bool) {
+		respdata := resp.Data["member_group_ids"]
+		if err = d.Set("member_group_ids", respdata); err != nil {
+			return fmt.Errorf("error setting member group ids for IdentityGroupMemberGroupIds %q: %s", id, err)
+		}
+	} else {
+		userMemberGroupIds := d.Get("member_group_ids").(*schema.Set).List()
+		newMemberGroupIds := make([]string, 0)
+		apiMemberGroupIds := make([]interface{}, 0)
+		if v, ok := resp.Data["member_group_ids"]; ok && v != nil {
+			apiMemberGroupIds = v.([]interface{})
+		}
+
+		for _, memberGroupId := range userMemberGroupIds {
+			if found, _ := util.SliceHasElement(apiMemberGroupIds, memberGroupId); found {
+				newMemberGroupIds = append(newMemberGroupIds, memberGroupId.(string))
+			}
+		}
+		if err = d.Set("member_group_ids", newMemberGroupIds); err != nil {
+			return fmt.Errorf("error setting member group ids for IdentityGroupMemberGroupIds %q: %s", id, err)
+		}
+	}
+	return nil
+}
+
+func identityGroupMemberGroupIdsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Get("group_id").(string)
+
+	log.Printf("[DEBUG] Deleting IdentityGroupMemberGroupIds %q", id)
+	path := identityGroupIDPath(id)
+
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	err := identityGroupMemberGroupIdsWrite(client, id, false, func(apiMemberGroupIds []interface{}) []interface{} {
+		if d.Get("exclusive").(bool) {
+			return make([]interface{}, 0)
+		}
+
+		for _, memberGroupId := range d.Get("member_group_ids").(*schema.Set).List() {
+			apiMemberGroupIds = util.SliceRemoveIfPresent(apiMemberGroupIds, memberGroupId)
+		}
+		return apiMemberGroupIds
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Deleted IdentityGroupMemberGroupIds %q", id)
+
+	return nil
+}
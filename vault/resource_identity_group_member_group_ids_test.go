@@ -0,0 +1,195 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityGroupMemberGroupIdsExclusive(t *testing.T) {
+	group := acctest.RandomWithPrefix("test-group")
+	resName := "vault_identity_group_member_group_ids.members"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupMemberGroupIdsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigExclusive(group, `[vault_identity_group.dev.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "group_id", "vault_identity_group.group", "id"),
+					resource.TestCheckResourceAttr(resName, "group_name", group),
+					resource.TestCheckResourceAttr(resName, "member_group_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigExclusive(group, `[vault_identity_group.dev.id, vault_identity_group.test.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "member_group_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigExclusive(group, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "member_group_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityGroupMemberGroupIdsNonExclusive(t *testing.T) {
+	group := acctest.RandomWithPrefix("test-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupMemberGroupIdsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigNonExclusive(group),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group_member_group_ids.dev", "member_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_group_member_group_ids.test", "member_group_ids.#", "1"),
+					testAccIdentityGroupMemberGroupIdsCheckCount("vault_identity_group.group", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityGroupMemberGroupIdsExternalGroup(t *testing.T) {
+	group := acctest.RandomWithPrefix("test-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupMemberGroupIdsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name = "%s"
+  type = "external"
+}
+
+resource "vault_identity_group" "dev" {
+  name = "%s-dev"
+}
+
+resource "vault_identity_group_member_group_ids" "members" {
+  group_id         = vault_identity_group.group.id
+  member_group_ids = [vault_identity_group.dev.id]
+}
+`, group, group),
+				ExpectError: regexp.MustCompile("member groups cannot be set on external groups"),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityGroupMemberGroupIdsDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_group_member_group_ids" {
+			continue
+		}
+
+		group, err := readIdentityGroup(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			continue
+		}
+		if v, ok := group.Data["member_group_ids"]; ok && v != nil && len(v.([]interface{})) != 0 {
+			return fmt.Errorf("identity group %q still has member groups", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityGroupMemberGroupIdsCheckCount(resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		group, err := readIdentityGroup(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("identity group %q does not exist", rs.Primary.ID)
+		}
+
+		var members []interface{}
+		if v, ok := group.Data["member_group_ids"]; ok && v != nil {
+			members = v.([]interface{})
+		}
+		if len(members) != count {
+			return fmt.Errorf("expected identity group %q to have %d member groups, got %d", rs.Primary.ID, count, len(members))
+		}
+		return nil
+	}
+}
+
+func testAccIdentityGroupMemberGroupIdsConfigExclusive(groupName, members string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name                      = "%s"
+  external_member_group_ids = true
+}
+
+resource "vault_identity_group" "dev" {
+  name = "%s-dev"
+}
+
+resource "vault_identity_group" "test" {
+  name = "%s-test"
+}
+
+resource "vault_identity_group_member_group_ids" "members" {
+  group_id         = vault_identity_group.group.id
+  member_group_ids = %s
+}
+`, groupName, groupName, groupName, members)
+}
+
+func testAccIdentityGroupMemberGroupIdsConfigNonExclusive(groupName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name                      = "%s"
+  external_member_group_ids = true
+}
+
+resource "vault_identity_group" "dev" {
+  name = "%s-dev"
+}
+
+resource "vault_identity_group" "test" {
+  name = "%s-test"
+}
+
+resource "vault_identity_group_member_group_ids" "dev" {
+  group_id         = vault_identity_group.group.id
+  exclusive        = false
+  member_group_ids = [vault_identity_group.dev.id]
+}
+
+resource "vault_identity_group_member_group_ids" "test" {
+  group_id         = vault_identity_group.group.id
+  exclusive        = false
+  member_group_ids = [vault_identity_group.test.id]
+}
+`, groupName, groupName, groupName)
+}
@@ -117,7 +117,10 @@ func identityGroupPoliciesRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		userPolicies := d.Get("policies").(*schema.Set).List()
 		newPolicies := make([]string, 0)
-		apiPolicies := resp.Data["policies"].([]interface{})
+		apiPolicies := make([]interface{}, 0)
+		if v, ok := resp.Data["policies"]; ok && v != nil {
+			apiPolicies = v.([]interface{})
+		}
 
 		for _, policy := range userPolicies {
 			if found, _ := util.SliceHasElement(apiPolicies, policy); found {
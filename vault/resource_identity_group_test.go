@@ -3,12 +3,16 @@ package vault
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/hashicorp/vault/api"
 )
@@ -112,6 +116,165 @@ func TestAccIdentityGroupExternal(t *testing.T) {
 	})
 }
 
+func TestAccIdentityGroupUpdate_concurrentChanges(t *testing.T) {
+	group := acctest.RandomWithPrefix("test-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupConfigConcurrent(group, `["test"]`, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group.group", "policies.#", "1"),
+					resource.TestCheckResourceAttrSet("vault_identity_group.group", "modification_time"),
+				),
+			},
+			{
+				// The member groups are changed right before the group is
+				// updated, only the policies are written so that the
+				// change is kept. The member group is in the config as
+				// well, so that the plan is empty after the apply.
+				Config: testAccIdentityGroupConfigConcurrent(group, `["dev", "test"]`, `[vault_identity_group.other_group.id]`, `{
+    member_group_ids = [vault_identity_group.other_group.id]
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group.group", "policies.#", "2"),
+					testAccIdentityGroupCheckMemberGroups("vault_identity_group.group", "vault_identity_group.other_group"),
+				),
+			},
+			{
+				// The policies are changed right before the group is
+				// updated, writing them would discard that change.
+				Config: testAccIdentityGroupConfigConcurrent(group, `["prod"]`, `[vault_identity_group.other_group.id]`, `{
+    policies = ["ops"]
+  }`),
+				ExpectError: regexp.MustCompile("policies of IdentityGroup .* was modified outside of Terraform"),
+			},
+		},
+	})
+}
+
+func TestIdentityGroupUpdate_retry(t *testing.T) {
+	tests := []struct {
+		name          string
+		discarded     int
+		expectedErr   string
+		expectedWrite int
+	}{
+		{
+			name:          "written",
+			expectedWrite: 1,
+		},
+		{
+			name:          "discarded-once",
+			discarded:     1,
+			expectedWrite: 2,
+		},
+		{
+			name:          "always-discarded",
+			discarded:     identityGroupUpdateMaxAttempts,
+			expectedErr:   "group was modified concurrently",
+			expectedWrite: identityGroupUpdateMaxAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := map[string]interface{}{
+				"id":                "group-id",
+				"name":              "group",
+				"type":              "internal",
+				"policies":          []interface{}{},
+				"modification_time": "0",
+			}
+			writes := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/identity/group/id/group-id" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if r.Method == http.MethodPut {
+					writes++
+					var data map[string]interface{}
+					if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+						t.Errorf("unexpected request body: %s", err)
+					}
+					// a concurrent write of the whole group discards the
+					// change right after it is made
+					if writes > tt.discarded {
+						for k, v := range data {
+							group[k] = v
+						}
+					}
+					group["modification_time"] = strconv.Itoa(writes)
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{"data": group})
+			}))
+			defer server.Close()
+
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d := schema.TestResourceDataRaw(t, identityGroupResource().Schema, map[string]interface{}{
+				"policies": []interface{}{"dev"},
+			})
+			d.SetId("group-id")
+			d.Set("modification_time", "0")
+
+			err = identityGroupUpdate(d, client)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.expectedErr, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if writes != tt.expectedWrite {
+				t.Fatalf("expected %d writes, got %d", tt.expectedWrite, writes)
+			}
+			if tt.expectedErr == "" && fmt.Sprint(group["policies"]) != "[dev]" {
+				t.Fatalf("expected policies [dev], got %v", group["policies"])
+			}
+		})
+	}
+}
+
+func testAccIdentityGroupCheckMemberGroups(groupResource, memberResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		group, ok := s.RootModule().Resources[groupResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", groupResource)
+		}
+		member, ok := s.RootModule().Resources[memberResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", memberResource)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := readIdentityGroup(client, group.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("identity group %q does not exist", group.Primary.ID)
+		}
+
+		memberGroupIDs, _ := resp.Data["member_group_ids"].([]interface{})
+		if len(memberGroupIDs) != 1 || memberGroupIDs[0] != member.Primary.ID {
+			return fmt.Errorf("expected member_group_ids to be [%q], got %v", member.Primary.ID, memberGroupIDs)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIdentityGroupDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -289,6 +452,45 @@ resource "vault_identity_group" "other_group" {
 `, groupName, entityName, groupName)
 }
 
+// testAccIdentityGroupConfigConcurrent returns a config in which the group is
+// updated right after the data in concurrentWrite, if any, is written to it
+// outside of the resource. The member groups are managed externally, so
+// memberGroupIDs is never written by the group resource.
+func testAccIdentityGroupConfigConcurrent(groupName, policies, memberGroupIDs, concurrentWrite string) string {
+	config := fmt.Sprintf(`
+resource "vault_identity_group" "other_group" {
+  name = "other_%s"
+}
+`, groupName)
+
+	var memberGroups, dependsOn string
+	if memberGroupIDs != "" {
+		memberGroups = `
+  member_group_ids = ` + memberGroupIDs
+	}
+	if concurrentWrite != "" {
+		config += fmt.Sprintf(`
+resource "vault_generic_endpoint" "concurrent" {
+  path = "identity/group/name/%s"
+  disable_read = true
+  disable_delete = true
+  data_json = jsonencode(%s)
+}
+`, groupName, concurrentWrite)
+		dependsOn = `
+  depends_on = [vault_generic_endpoint.concurrent]`
+	}
+
+	return config + fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name = "%s"
+  policies = %s%s
+
+  external_member_group_ids = true%s
+}
+`, groupName, policies, memberGroups, dependsOn)
+}
+
 func testAccIdentityGroupConfigExternalMembers(groupName string) string {
 	return fmt.Sprintf(`
 resource "vault_identity_group" "group" {
@@ -0,0 +1,197 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityMfaTotpPath = "identity/mfa/method/totp"
+
+var identityMfaTotpFields = []string{
+	"issuer",
+	"period",
+	"key_size",
+	"qr_size",
+	"algorithm",
+	"digits",
+	"skew",
+	"max_validation_attempts",
+}
+
+func identityMfaTotpResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityMfaTotpCreate,
+		Update: identityMfaTotpUpdate,
+		Read:   identityMfaTotpRead,
+		Delete: identityMfaTotpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"issuer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the key's issuing organization.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The length of time in seconds used to generate a counter for the TOTP token calculation.",
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Specifies the size in bytes of the generated key.",
+			},
+			"qr_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      200,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The pixel size of the generated square QR code.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SHA1",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+				Description:  "Specifies the hashing algorithm used to generate the TOTP code. Options include SHA1, SHA256 and SHA512.",
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      6,
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+				Description:  "The number of digits in the generated TOTP token. This value can either be 6 or 8.",
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+				Description:  "The number of delay periods that are allowed when validating a TOTP token. This value can either be 0 or 1.",
+			},
+			"max_validation_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of consecutive failed validation attempts allowed.",
+			},
+			"method_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the MFA method, to be referenced by login enforcements.",
+			},
+		},
+	}
+}
+
+func identityMfaTotpUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
+	data["issuer"] = d.Get("issuer").(string)
+	data["period"] = d.Get("period").(int)
+	data["key_size"] = d.Get("key_size").(int)
+	data["qr_size"] = d.Get("qr_size").(int)
+	data["algorithm"] = d.Get("algorithm").(string)
+	data["digits"] = d.Get("digits").(int)
+	data["skew"] = d.Get("skew").(int)
+	data["max_validation_attempts"] = d.Get("max_validation_attempts").(int)
+}
+
+func identityMfaTotpCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{}
+	identityMfaTotpUpdateFields(d, data)
+
+	log.Printf("[DEBUG] Creating IdentityMfaTotp method at %q", identityMfaTotpPath)
+	resp, err := client.Logical().Write(identityMfaTotpPath, data)
+	if err != nil {
+		return fmt.Errorf("error creating IdentityMfaTotp method: %s", err)
+	}
+	if resp == nil {
+		return fmt.Errorf("error creating IdentityMfaTotp method: no method_id returned by Vault")
+	}
+
+	id, ok := resp.Data["method_id"].(string)
+	if !ok || id == "" {
+		return fmt.Errorf("error creating IdentityMfaTotp method: no method_id returned by Vault")
+	}
+	log.Printf("[DEBUG] Created IdentityMfaTotp method %q", id)
+
+	d.SetId(id)
+
+	return identityMfaTotpRead(d, meta)
+}
+
+func identityMfaTotpUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+	path := identityMfaTotpIDPath(id)
+
+	data := map[string]interface{}{}
+	identityMfaTotpUpdateFields(d, data)
+
+	log.Printf("[DEBUG] Updating IdentityMfaTotp method %q", id)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating IdentityMfaTotp method %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityMfaTotp method %q", id)
+
+	return identityMfaTotpRead(d, meta)
+}
+
+func identityMfaTotpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+	path := identityMfaTotpIDPath(id)
+
+	log.Printf("[DEBUG] Reading IdentityMfaTotp method %q", id)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityMfaTotp method %q: %s", id, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] IdentityMfaTotp method %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range identityMfaTotpFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on IdentityMfaTotp method %q: %s", k, id, err)
+			}
+		}
+	}
+	d.Set("method_id", id)
+
+	return nil
+}
+
+func identityMfaTotpDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+	path := identityMfaTotpIDPath(id)
+
+	log.Printf("[DEBUG] Deleting IdentityMfaTotp method %q", id)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting IdentityMfaTotp method %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityMfaTotp method %q", id)
+
+	return nil
+}
+
+func identityMfaTotpIDPath(id string) string {
+	return fmt.Sprintf("%s/%s", identityMfaTotpPath, id)
+}
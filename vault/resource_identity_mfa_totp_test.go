@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityMfaTotp(t *testing.T) {
+	issuer := acctest.RandomWithPrefix("issuer")
+	resourceName := "vault_identity_mfa_totp.totp"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityMfaTotpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMfaTotpConfig(issuer),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer", issuer),
+					resource.TestCheckResourceAttr(resourceName, "period", "30"),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA1"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "6"),
+					resource.TestCheckResourceAttr(resourceName, "skew", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_validation_attempts", "5"),
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+				),
+			},
+			{
+				Config: testAccIdentityMfaTotpConfigUpdate(issuer),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer", issuer),
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "key_size", "30"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "8"),
+					resource.TestCheckResourceAttr(resourceName, "skew", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_validation_attempts", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityMfaTotpDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_mfa_totp" {
+			continue
+		}
+		resp, err := client.Logical().Read(identityMfaTotpIDPath(rs.Primary.ID))
+		if err != nil {
+			return fmt.Errorf("error checking for identity MFA TOTP method %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("identity MFA TOTP method %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityMfaTotpConfig(issuer string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "totp" {
+  issuer = "%s"
+}`, issuer)
+}
+
+func testAccIdentityMfaTotpConfigUpdate(issuer string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "totp" {
+  issuer                  = "%s"
+  period                  = 60
+  key_size                = 30
+  algorithm               = "SHA256"
+  digits                  = 8
+  skew                    = 0
+  max_validation_attempts = 3
+}`, issuer)
+}
@@ -0,0 +1,113 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcAssignmentPathTemplate = "identity/oidc/assignment/%s"
+
+func identityOidcAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcAssignmentWrite,
+		Update: identityOidcAssignmentWrite,
+		Read:   identityOidcAssignmentRead,
+		Delete: identityOidcAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the assignment.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"entity_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of Vault entity IDs.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"group_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of Vault group IDs.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func identityOidcAssignmentWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcAssignmentPath(name)
+
+	data := map[string]interface{}{
+		"entity_ids": d.Get("entity_ids").(*schema.Set).List(),
+		"group_ids":  d.Get("group_ids").(*schema.Set).List(),
+	}
+
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing IdentityOidcAssignment %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityOidcAssignment %s to %s", name, path)
+
+	d.SetId(name)
+
+	return identityOidcAssignmentRead(d, meta)
+}
+
+func identityOidcAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcAssignmentPath(name)
+
+	log.Printf("[DEBUG] Reading IdentityOidcAssignment %s from %s", name, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcAssignment %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read IdentityOidcAssignment %s", name)
+	if resp == nil {
+		log.Printf("[WARN] IdentityOidcAssignment %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"entity_ids", "group_ids"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\" on IdentityOidcAssignment %q: %s", k, path, err)
+		}
+	}
+	return nil
+}
+
+func identityOidcAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcAssignmentPath(name)
+
+	log.Printf("[DEBUG] Deleting IdentityOidcAssignment %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityOidcAssignment %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityOidcAssignment %q", name)
+
+	return nil
+}
+
+func identityOidcAssignmentPath(name string) string {
+	return fmt.Sprintf(identityOidcAssignmentPathTemplate, name)
+}
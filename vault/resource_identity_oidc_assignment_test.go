@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcAssignment(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-assignment")
+	resourceName := "vault_identity_oidc_assignment.assignment"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcAssignmentConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityOidcAssignmentDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_assignment" {
+			continue
+		}
+		resp, err := client.Logical().Read(identityOidcAssignmentPath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("OIDC assignment %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcAssignmentConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "test" {
+  name = "%[1]s"
+}
+
+resource "vault_identity_group" "test" {
+  name = "%[1]s"
+}
+
+resource "vault_identity_oidc_assignment" "assignment" {
+  name       = "%[1]s"
+  entity_ids = [vault_identity_entity.test.id]
+  group_ids  = [vault_identity_group.test.id]
+}
+`, name)
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcClientPathTemplate = "identity/oidc/client/%s"
+
+func identityOidcClientResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcClientWrite,
+		Update: identityOidcClientWrite,
+		Read:   identityOidcClientRead,
+		Delete: identityOidcClientDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the client.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"key": {
+				Type:        schema.TypeString,
+				Description: "A reference to a named key resource in Vault. This cannot be modified after creation.",
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+			},
+
+			"redirect_uris": {
+				Type:        schema.TypeSet,
+				Description: "Redirection URI values used by the client.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"assignments": {
+				Type:        schema.TypeSet,
+				Description: "A list of assignment resources associated with the client.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"id_token_ttl": {
+				Type:        schema.TypeInt,
+				Description: "The time-to-live for ID tokens obtained by the client in seconds.",
+				Optional:    true,
+				Default:     86400,
+			},
+
+			"access_token_ttl": {
+				Type:        schema.TypeInt,
+				Description: "The time-to-live for access tokens obtained by the client in seconds.",
+				Optional:    true,
+				Default:     86400,
+			},
+
+			"client_type": {
+				Type:         schema.TypeString,
+				Description:  "The client type based on its ability to maintain confidentiality of credentials, either confidential or public.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"confidential", "public"}, false),
+			},
+
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The Client ID from Vault.",
+				Computed:    true,
+			},
+
+			"client_secret": {
+				Type:        schema.TypeString,
+				Description: "The Client Secret from Vault.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func identityOidcClientWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcClientPath(name)
+
+	data := map[string]interface{}{
+		"key":              d.Get("key").(string),
+		"redirect_uris":    d.Get("redirect_uris").(*schema.Set).List(),
+		"assignments":      d.Get("assignments").(*schema.Set).List(),
+		"id_token_ttl":     d.Get("id_token_ttl").(int),
+		"access_token_ttl": d.Get("access_token_ttl").(int),
+	}
+	if v, ok := d.GetOk("client_type"); ok {
+		data["client_type"] = v.(string)
+	}
+
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing IdentityOidcClient %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityOidcClient %s to %s", name, path)
+
+	d.SetId(name)
+
+	return identityOidcClientRead(d, meta)
+}
+
+func identityOidcClientRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcClientPath(name)
+
+	log.Printf("[DEBUG] Reading IdentityOidcClient %s from %s", name, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcClient %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read IdentityOidcClient %s", name)
+	if resp == nil {
+		log.Printf("[WARN] IdentityOidcClient %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{
+		"key", "redirect_uris", "assignments", "id_token_ttl", "access_token_ttl",
+		"client_type", "client_id", "client_secret",
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\" on IdentityOidcClient %q: %s", k, path, err)
+		}
+	}
+	return nil
+}
+
+func identityOidcClientDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcClientPath(name)
+
+	log.Printf("[DEBUG] Deleting IdentityOidcClient %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityOidcClient %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityOidcClient %q", name)
+
+	return nil
+}
+
+func identityOidcClientPath(name string) string {
+	return fmt.Sprintf(identityOidcClientPathTemplate, name)
+}
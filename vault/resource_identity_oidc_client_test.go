@@ -0,0 +1,82 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcClient(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-client")
+	resourceName := "vault_identity_oidc_client.client"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcClientConfig(name, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "key", "default"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_uris.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "assignments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_token_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "access_token_ttl", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "client_type", "confidential"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_secret"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcClientConfig(name, 1800),
+				Check:  resource.TestCheckResourceAttr(resourceName, "id_token_ttl", "1800"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityOidcClientDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_client" {
+			continue
+		}
+		resp, err := client.Logical().Read(identityOidcClientPath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("OIDC client %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcClientConfig(name string, idTokenTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_assignment" "test" {
+  name       = "%[1]s"
+  entity_ids = ["ascbascas-2231a-sdfaa"]
+}
+
+resource "vault_identity_oidc_client" "client" {
+  name             = "%[1]s"
+  redirect_uris    = ["http://127.0.0.1:8251/callback"]
+  assignments      = [vault_identity_oidc_assignment.test.name]
+  id_token_ttl     = %[2]d
+  access_token_ttl = 7200
+}
+`, name, idTokenTTL)
+}
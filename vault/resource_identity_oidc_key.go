@@ -9,7 +9,10 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
-const identityOidcKeyPathTemplate = "identity/oidc/key/%s"
+const (
+	identityOidcKeyPathTemplate       = "identity/oidc/key/%s"
+	identityOidcKeyRotatePathTemplate = "identity/oidc/key/%s/rotate"
+)
 
 var (
 	identityOidcKeyFields = []string{
@@ -67,6 +70,13 @@ func identityOidcKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+
+			"rotate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Rotate the key when this value is toggled. The key is also rotated on creation when set to true.",
+			},
 		},
 	}
 }
@@ -93,10 +103,18 @@ func identityOidcKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	data := make(map[string]interface{})
 
 	identityOidcKeyUpdateFields(d, data)
-	identityOidcKeyApiWrite(name, data, client)
+	if err := identityOidcKeyApiWrite(name, data, client); err != nil {
+		return err
+	}
 
 	d.SetId(name)
 
+	if d.Get("rotate").(bool) {
+		if err := identityOidcKeyApiRotate(name, d.Get("verification_ttl").(int), client); err != nil {
+			return err
+		}
+	}
+
 	return identityOidcKeyRead(d, meta)
 }
 
@@ -113,7 +131,15 @@ func identityOidcKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	data := map[string]interface{}{}
 
 	identityOidcKeyUpdateFields(d, data)
-	identityOidcKeyApiWrite(name, data, client)
+	if err := identityOidcKeyApiWrite(name, data, client); err != nil {
+		return err
+	}
+
+	if d.HasChange("rotate") {
+		if err := identityOidcKeyApiRotate(name, d.Get("verification_ttl").(int), client); err != nil {
+			return err
+		}
+	}
 
 	return identityOidcKeyRead(d, meta)
 }
@@ -216,3 +242,18 @@ func identityOidcKeyApiWrite(name string, data map[string]interface{}, client *a
 
 	return nil
 }
+
+func identityOidcKeyApiRotate(name string, verificationTTL int, client *api.Client) error {
+	path := fmt.Sprintf(identityOidcKeyRotatePathTemplate, name)
+
+	log.Printf("[DEBUG] Rotating IdentityOidcKey %s at %s", name, path)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"verification_ttl": verificationTTL,
+	})
+	if err != nil {
+		return fmt.Errorf("error rotating IdentityOidcKey %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Rotated IdentityOidcKey %q", name)
+
+	return nil
+}
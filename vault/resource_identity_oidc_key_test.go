@@ -33,9 +33,10 @@ func TestAccIdentityOidcKey(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "vault_identity_oidc_key.key",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "vault_identity_oidc_key.key",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate"},
 			},
 		},
 	})
@@ -79,6 +80,33 @@ func TestAccIdentityOidcKeyUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIdentityOidcKeyRotate(t *testing.T) {
+	key := acctest.RandomWithPrefix("test-key")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcKeyConfig(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityOidcKeyCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotate", "false"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcKeyConfigRotate(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityOidcKeyCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotate", "true"),
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotation_period", "3600"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityOidcKeyDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -215,3 +243,16 @@ resource "vault_identity_oidc_key" "key" {
 	allowed_client_ids = ["*"]
 }`, entityName)
 }
+
+func testAccIdentityOidcKeyConfigRotate(entityName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name = "%s"
+	algorithm = "RS256"
+	rotation_period = 3600
+
+	allowed_client_ids = []
+
+	rotate = true
+}`, entityName)
+}
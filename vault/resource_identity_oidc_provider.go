@@ -0,0 +1,143 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcProviderPathTemplate = "identity/oidc/provider/%s"
+
+func identityOidcProviderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcProviderWrite,
+		Update: identityOidcProviderWrite,
+		Read:   identityOidcProviderRead,
+		Delete: identityOidcProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the provider.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"https_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Set to true if the issuer endpoint uses HTTPS.",
+				Optional:    true,
+				Default:     true,
+			},
+
+			"issuer_host": {
+				Type:        schema.TypeString,
+				Description: "The host for the issuer. Can be either host or host:port. Defaults to the api_addr of the Vault server.",
+				Optional:    true,
+			},
+
+			"allowed_client_ids": {
+				Type:        schema.TypeSet,
+				Description: "The client IDs that are permitted to use the provider. If empty, no clients are allowed. If \"*\", all clients are allowed.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"scopes_supported": {
+				Type:        schema.TypeSet,
+				Description: "The scopes available for requesting on the provider.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Specifies what will be used as the scheme://host:port component for the iss claim of ID tokens.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func identityOidcProviderWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcProviderPath(name)
+
+	data := map[string]interface{}{
+		"allowed_client_ids": d.Get("allowed_client_ids").(*schema.Set).List(),
+		"scopes_supported":   d.Get("scopes_supported").(*schema.Set).List(),
+	}
+
+	// An empty issuer resets it to the api_addr of the Vault server.
+	issuer := ""
+	if v, ok := d.GetOk("issuer_host"); ok {
+		scheme := "http"
+		if d.Get("https_enabled").(bool) {
+			scheme = "https"
+		}
+		issuer = fmt.Sprintf("%s://%s", scheme, v.(string))
+	}
+	data["issuer"] = issuer
+
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing IdentityOidcProvider %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityOidcProvider %s to %s", name, path)
+
+	d.SetId(name)
+
+	return identityOidcProviderRead(d, meta)
+}
+
+func identityOidcProviderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcProviderPath(name)
+
+	log.Printf("[DEBUG] Reading IdentityOidcProvider %s from %s", name, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcProvider %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read IdentityOidcProvider %s", name)
+	if resp == nil {
+		log.Printf("[WARN] IdentityOidcProvider %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"allowed_client_ids", "scopes_supported", "issuer"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\" on IdentityOidcProvider %q: %s", k, path, err)
+		}
+	}
+	return nil
+}
+
+func identityOidcProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcProviderPath(name)
+
+	log.Printf("[DEBUG] Deleting IdentityOidcProvider %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityOidcProvider %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityOidcProvider %q", name)
+
+	return nil
+}
+
+func identityOidcProviderPath(name string) string {
+	return fmt.Sprintf(identityOidcProviderPathTemplate, name)
+}
@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcProvider(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-provider")
+	resourceName := "vault_identity_oidc_provider.provider"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcProviderConfig(name, "example.com:8200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "issuer",
+						fmt.Sprintf("https://example.com:8200/v1/identity/oidc/provider/%s", name)),
+					resource.TestCheckResourceAttr(resourceName, "allowed_client_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scopes_supported.#", "1"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcProviderConfig(name, "vault.example.com"),
+				Check: resource.TestCheckResourceAttr(resourceName, "issuer",
+					fmt.Sprintf("https://vault.example.com/v1/identity/oidc/provider/%s", name)),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"issuer_host", "https_enabled"},
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityOidcProviderDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_provider" {
+			continue
+		}
+		resp, err := client.Logical().Read(identityOidcProviderPath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("OIDC provider %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcProviderConfig(name, issuerHost string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_scope" "test" {
+  name     = "%[1]s"
+  template = jsonencode({ groups = "{{identity.entity.groups.names}}" })
+}
+
+resource "vault_identity_oidc_client" "test" {
+  name          = "%[1]s"
+  redirect_uris = ["http://127.0.0.1:8251/callback"]
+}
+
+resource "vault_identity_oidc_provider" "provider" {
+  name               = "%[1]s"
+  issuer_host        = "%[2]s"
+  allowed_client_ids = [vault_identity_oidc_client.test.client_id]
+  scopes_supported   = [vault_identity_oidc_scope.test.name]
+}
+`, name, issuerHost)
+}
@@ -86,7 +86,13 @@ func identityOidcRoleCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(name)
 
-	return identityOidcRoleRead(d, meta)
+	if err := identityOidcRoleRead(d, meta); err != nil {
+		return err
+	}
+
+	identityOidcRoleCheckKey(client, d)
+
+	return nil
 }
 
 func identityOidcRoleUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -106,7 +112,38 @@ func identityOidcRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Updated IdentityOidcRole %q", name)
 
-	return identityOidcRoleRead(d, meta)
+	if err := identityOidcRoleRead(d, meta); err != nil {
+		return err
+	}
+
+	identityOidcRoleCheckKey(client, d)
+
+	return nil
+}
+
+// identityOidcRoleCheckKey warns when the key of the role doesn't allow the
+// client ID of the role, in which case Vault refuses to issue tokens. It is
+// not an error since the key is commonly configured with the client ID of
+// the role after the role is created.
+func identityOidcRoleCheckKey(client *api.Client, d *schema.ResourceData) {
+	key := d.Get("key").(string)
+	clientID := d.Get("client_id").(string)
+
+	resp, err := client.Logical().Read(identityOidcKeyPath(key))
+	if err != nil || resp == nil {
+		log.Printf("[WARN] Unable to read IdentityOidcKey %q of IdentityOidcRole %q: %v", key, d.Id(), err)
+		return
+	}
+
+	allowed, _ := resp.Data["allowed_client_ids"].([]interface{})
+	for _, v := range allowed {
+		if v == "*" || v == clientID {
+			return
+		}
+	}
+
+	log.Printf("[WARN] IdentityOidcKey %q does not allow the client ID %q of IdentityOidcRole %q, "+
+		"tokens cannot be issued for the role until it is added to the allowed_client_ids of the key", key, clientID, d.Id())
 }
 
 func identityOidcRoleRead(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcScope(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-scope")
+	resourceName := "vault_identity_oidc_scope.scope"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcScopeConfig(name, "groups"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "test scope"),
+					resource.TestCheckResourceAttr(resourceName, "template", `{"groups":{{identity.entity.groups.names}}}`),
+				),
+			},
+			{
+				Config: testAccIdentityOidcScopeConfig(name, "roles"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "template", `{"roles":{{identity.entity.groups.names}}}`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityOidcScopeDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_scope" {
+			continue
+		}
+		resp, err := client.Logical().Read(identityOidcScopePath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("OIDC scope %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcScopeConfig(name, claim string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_scope" "scope" {
+  name        = "%s"
+  template    = "{\"%s\":{{identity.entity.groups.names}}}"
+  description = "test scope"
+}
+`, name, claim)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -44,6 +45,12 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			Required:    true,
 			Description: "The claim to use to uniquely identify the user; this will be used as the name for the Identity entity alias created due to a successful login.",
 		},
+		"user_claim_json_pointer": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies if the user_claim value uses JSON pointer syntax for referencing claims. By default, the user_claim value will not use JSON pointer.",
+		},
 		"clock_skew_leeway": {
 			Type:        schema.TypeInt,
 			Optional:    true,
@@ -116,6 +123,12 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			Default:     false,
 			Description: "Log received OIDC tokens and claims when debug-level logging is active. Not recommended in production since sensitive information may be present in OIDC responses.",
 		},
+		"max_age": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Specifies the allowable elapsed time in seconds since the last time the user was actively authenticated with the OIDC provider. Only applicable with \"oidc\" roles.",
+		},
 		"backend": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -198,10 +211,28 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: jwtAuthBackendRoleDiff,
+
 		Schema: fields,
 	}
 }
 
+func jwtAuthBackendRoleDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("role_type").(string) == "jwt" && d.Get("max_age").(int) > 0 {
+		return fmt.Errorf("'max_age' is only applicable with \"oidc\" roles")
+	}
+
+	if !d.Get("user_claim_json_pointer").(bool) {
+		return nil
+	}
+
+	userClaim := d.Get("user_claim").(string)
+	if userClaim != "" && !strings.HasPrefix(userClaim, "/") {
+		return fmt.Errorf("'user_claim' must be a JSON pointer starting with '/' when 'user_claim_json_pointer' is true, got: %q", userClaim)
+	}
+	return nil
+}
+
 func jwtAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -413,6 +444,12 @@ func jwtAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := resp.Data["verbose_oidc_logging"]; ok {
 		d.Set("verbose_oidc_logging", v)
 	}
+	if v, ok := resp.Data["user_claim_json_pointer"]; ok {
+		d.Set("user_claim_json_pointer", v)
+	}
+	if v, ok := resp.Data["max_age"]; ok {
+		d.Set("max_age", v)
+	}
 
 	d.Set("backend", backend)
 	d.Set("role_name", role)
@@ -517,6 +554,10 @@ func jwtAuthBackendRoleDataToWrite(d *schema.ResourceData, create bool) map[stri
 	data["bound_audiences"] = util.TerraformSetToStringArray(d.Get("bound_audiences"))
 	data["user_claim"] = d.Get("user_claim").(string)
 
+	if v, ok := d.GetOkExists("user_claim_json_pointer"); ok {
+		data["user_claim_json_pointer"] = v.(bool)
+	}
+
 	if dataList := util.TerraformSetToStringArray(d.Get("allowed_redirect_uris")); len(dataList) > 0 {
 		data["allowed_redirect_uris"] = dataList
 	}
@@ -572,6 +613,10 @@ func jwtAuthBackendRoleDataToWrite(d *schema.ResourceData, create bool) map[stri
 
 	data["verbose_oidc_logging"] = d.Get("verbose_oidc_logging").(bool)
 
+	if d.Get("role_type").(string) != "jwt" {
+		data["max_age"] = d.Get("max_age").(int)
+	}
+
 	// Deprecated Fields
 	if dataList := util.TerraformSetToStringArray(d.Get("policies")); len(dataList) > 0 {
 		data["policies"] = dataList
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -257,6 +258,41 @@ func TestAccJWTAuthBackendRole_full(t *testing.T) {
 	})
 }
 
+func TestAccJWTAuthBackendRole_userClaimJSONPointer(t *testing.T) {
+	backend := acctest.RandomWithPrefix("jwt")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckJWTAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJWTAuthBackendRoleConfig_userClaimJSONPointer(backend, role, "/user/name", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"user_claim", "/user/name"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"user_claim_json_pointer", "true"),
+				),
+			},
+			{
+				Config: testAccJWTAuthBackendRoleConfig_userClaimJSONPointer(backend, role, "https://vault/user", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"user_claim", "https://vault/user"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"user_claim_json_pointer", "false"),
+				),
+			},
+			{
+				Config:      testAccJWTAuthBackendRoleConfig_userClaimJSONPointer(backend, role, "https://vault/user", true),
+				ExpectError: regexp.MustCompile("must be a JSON pointer"),
+			},
+		},
+	})
+}
+
 func TestAccJWTAuthBackendRoleOIDC_full(t *testing.T) {
 	backend := acctest.RandomWithPrefix("oidc")
 	role := acctest.RandomWithPrefix("test-role")
@@ -321,6 +357,8 @@ func TestAccJWTAuthBackendRoleOIDC_full(t *testing.T) {
 						"claim_mappings.preferred_language", "language"),
 					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
 						"verbose_oidc_logging", "true"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"max_age", "3600"),
 				),
 			},
 		},
@@ -616,6 +654,24 @@ resource "vault_jwt_auth_backend_role" "role" {
 }`, backend, role)
 }
 
+func testAccJWTAuthBackendRoleConfig_userClaimJSONPointer(backend, role, userClaim string, jsonPointer bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "jwt" {
+  type = "jwt"
+  path = "%s"
+}
+
+resource "vault_jwt_auth_backend_role" "role" {
+  backend = "${vault_auth_backend.jwt.path}"
+  role_name = "%s"
+  role_type = "jwt"
+
+  bound_audiences = ["https://myco.test"]
+  user_claim = "%s"
+  user_claim_json_pointer = %t
+}`, backend, role, userClaim, jsonPointer)
+}
+
 func testAccJWTAuthBackendRoleConfigOIDC_full(backend, role string) string {
 	return fmt.Sprintf(`
 resource "vault_jwt_auth_backend" "jwt" {
@@ -657,6 +713,7 @@ resource "vault_jwt_auth_backend_role" "role" {
   }
 
   verbose_oidc_logging = true
+  max_age = 3600
 }`, backend, role)
 }
 
@@ -0,0 +1,207 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var kmipSecretBackendRoleTLSFields = []string{
+	"tls_client_key_type",
+	"tls_client_key_bits",
+	"tls_client_ttl",
+}
+
+var kmipSecretBackendRoleOperationFields = []string{
+	"operation_activate",
+	"operation_add_attribute",
+	"operation_all",
+	"operation_create",
+	"operation_destroy",
+	"operation_discover_versions",
+	"operation_get",
+	"operation_get_attribute_list",
+	"operation_get_attributes",
+	"operation_locate",
+	"operation_none",
+	"operation_register",
+	"operation_rekey",
+	"operation_revoke",
+}
+
+func kmipSecretBackendRoleResource() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Path of the KMIP secret backend the role belongs to.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"scope": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the scope the role belongs to.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"tls_client_key_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Client certificate key type, rsa or ec.",
+			ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+		},
+		"tls_client_key_bits": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Client certificate key bits, valid values depend on tls_client_key_type.",
+		},
+		"tls_client_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Client certificate TTL in seconds.",
+		},
+	}
+	for _, k := range kmipSecretBackendRoleOperationFields {
+		s[k] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: fmt.Sprintf("Grant permission for the %s KMIP operation.", strings.TrimPrefix(k, "operation_")),
+		}
+	}
+
+	return &schema.Resource{
+		Create: kmipSecretBackendRoleCreate,
+		Read:   kmipSecretBackendRoleRead,
+		Update: kmipSecretBackendRoleUpdate,
+		Delete: kmipSecretBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func kmipSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	path := kmipSecretBackendRolePath(d.Get("backend").(string), d.Get("scope").(string), d.Get("role").(string))
+
+	d.SetId(path)
+
+	return kmipSecretBackendRoleUpdate(d, meta)
+}
+
+func kmipSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	data := map[string]interface{}{}
+	for _, k := range kmipSecretBackendRoleTLSFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	// Vault only records the operations that are granted, so all of them are
+	// sent for revocations to be applied.
+	for _, k := range kmipSecretBackendRoleOperationFields {
+		data[k] = d.Get(k).(bool)
+	}
+
+	log.Printf("[DEBUG] Writing KMIP role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KMIP role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KMIP role %q", path)
+
+	return kmipSecretBackendRoleRead(d, meta)
+}
+
+func kmipSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, scope, role, err := kmipSecretBackendRoleFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for KMIP role: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading KMIP role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KMIP role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KMIP role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] KMIP role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("scope", scope)
+	d.Set("role", role)
+
+	for _, k := range kmipSecretBackendRoleTLSFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on KMIP role %q: %s", k, path, err)
+			}
+		}
+	}
+	for _, k := range kmipSecretBackendRoleOperationFields {
+		v, _ := resp.Data[k].(bool)
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func kmipSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting KMIP role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting KMIP role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KMIP role %q", path)
+
+	return nil
+}
+
+func kmipSecretBackendRolePath(backend, scope, role string) string {
+	return kmipSecretBackendScopePath(backend, scope) + "/role/" + strings.Trim(role, "/")
+}
+
+func kmipSecretBackendRoleFromPath(path string) (string, string, string, error) {
+	idx := strings.LastIndex(path, "/role/")
+	if idx < 1 {
+		return "", "", "", fmt.Errorf("expected a path of the form <backend>/scope/<scope>/role/<role>")
+	}
+
+	role := path[idx+len("/role/"):]
+	if role == "" || strings.Contains(role, "/") {
+		return "", "", "", fmt.Errorf("expected a path of the form <backend>/scope/<scope>/role/<role>")
+	}
+
+	backend, scope, err := kmipSecretBackendScopeFromPath(path[:idx])
+	if err != nil {
+		return "", "", "", fmt.Errorf("expected a path of the form <backend>/scope/<scope>/role/<role>")
+	}
+
+	return backend, scope, role, nil
+}
@@ -0,0 +1,143 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kmipSecretBackendScopeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretBackendScopeCreate,
+		Read:   kmipSecretBackendScopeRead,
+		Update: kmipSecretBackendScopeUpdate,
+		Delete: kmipSecretBackendScopeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path of the KMIP secret backend the scope belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the scope.",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Force the deletion of the scope, even if it holds managed objects.",
+			},
+		},
+	}
+}
+
+func kmipSecretBackendScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := kmipSecretBackendScopePath(d.Get("backend").(string), d.Get("scope").(string))
+
+	log.Printf("[DEBUG] Creating KMIP scope %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{}); err != nil {
+		return fmt.Errorf("error creating KMIP scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created KMIP scope %q", path)
+
+	d.SetId(path)
+
+	return kmipSecretBackendScopeRead(d, meta)
+}
+
+// kmipSecretBackendScopeUpdate only records the changes of force, which is a local
+// setting used on deletion.
+func kmipSecretBackendScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	return kmipSecretBackendScopeRead(d, meta)
+}
+
+func kmipSecretBackendScopeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, scope, err := kmipSecretBackendScopeFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for KMIP scope: %s", path, err)
+	}
+
+	// Scopes cannot be read, only listed.
+	log.Printf("[DEBUG] Listing KMIP scopes of %q", backend)
+	resp, err := client.Logical().List(backend + "/scope")
+	if err != nil {
+		return fmt.Errorf("error listing KMIP scopes of %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Listed KMIP scopes of %q", backend)
+
+	found := false
+	if resp != nil {
+		keys, _ := resp.Data["keys"].([]interface{})
+		for _, k := range keys {
+			if k == scope {
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		log.Printf("[WARN] KMIP scope %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("scope", scope)
+
+	return nil
+}
+
+func kmipSecretBackendScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	data := map[string][]string{}
+	if d.Get("force").(bool) {
+		data["force"] = []string{"true"}
+	}
+
+	log.Printf("[DEBUG] Deleting KMIP scope %q", path)
+	if _, err := client.Logical().DeleteWithData(path, data); err != nil {
+		return fmt.Errorf("error deleting KMIP scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KMIP scope %q", path)
+
+	return nil
+}
+
+func kmipSecretBackendScopePath(backend, scope string) string {
+	return strings.Trim(backend, "/") + "/scope/" + strings.Trim(scope, "/")
+}
+
+func kmipSecretBackendScopeFromPath(path string) (string, string, error) {
+	idx := strings.LastIndex(path, "/scope/")
+	if idx < 1 {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/scope/<scope>")
+	}
+
+	scope := path[idx+len("/scope/"):]
+	if scope == "" || strings.Contains(scope, "/") {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/scope/<scope>")
+	}
+
+	return path[:idx], scope, nil
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretBackend_basic(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	path := acctest.RandomWithPrefix("tf-test-kmip")
+	resourceName := "vault_kmip_secret_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKMIPSecretBackendConfig(path, "127.0.0.1:5696", 4096),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "listen_addrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "server_hostnames.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "server_ips.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_ca_key_type", "rsa"),
+					resource.TestCheckResourceAttr(resourceName, "tls_ca_key_bits", "4096"),
+					resource.TestCheckResourceAttr(resourceName, "default_tls_client_key_type", "ec"),
+					resource.TestCheckResourceAttr(resourceName, "default_tls_client_key_bits", "256"),
+					resource.TestCheckResourceAttr(resourceName, "default_tls_client_ttl", "86400"),
+				),
+			},
+			{
+				Config: testKMIPSecretBackendConfig(path, "127.0.0.1:5697", 4096),
+				Check:  resource.TestCheckResourceAttr(resourceName, "listen_addrs.#", "1"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKMIPSecretBackendScopeAndRole(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	path := acctest.RandomWithPrefix("tf-test-kmip")
+	roleName := "vault_kmip_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKMIPSecretBackendRoleConfig(path, `
+  operation_activate = true
+  operation_get      = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kmip_secret_backend_scope.test", "scope", "scope-1"),
+					resource.TestCheckResourceAttr(roleName, "scope", "scope-1"),
+					resource.TestCheckResourceAttr(roleName, "role", "role-1"),
+					resource.TestCheckResourceAttr(roleName, "tls_client_key_type", "ec"),
+					resource.TestCheckResourceAttr(roleName, "tls_client_key_bits", "256"),
+					resource.TestCheckResourceAttr(roleName, "operation_activate", "true"),
+					resource.TestCheckResourceAttr(roleName, "operation_get", "true"),
+					resource.TestCheckResourceAttr(roleName, "operation_all", "false"),
+				),
+			},
+			{
+				Config: testKMIPSecretBackendRoleConfig(path, `
+  operation_all = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(roleName, "operation_activate", "false"),
+					resource.TestCheckResourceAttr(roleName, "operation_get", "false"),
+					resource.TestCheckResourceAttr(roleName, "operation_all", "true"),
+				),
+			},
+			{
+				ResourceName:      roleName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "vault_kmip_secret_backend_scope.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+		},
+	})
+}
+
+func testAccKMIPSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_backend" {
+			continue
+		}
+		if _, ok := mounts[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("mount %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testKMIPSecretBackendConfig(path, listenAddr string, caKeyBits int) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path                        = "%s"
+  description                 = "test description"
+  listen_addrs                = ["%s"]
+  server_hostnames            = ["localhost"]
+  server_ips                  = ["127.0.0.1"]
+  tls_ca_key_type             = "rsa"
+  tls_ca_key_bits             = %d
+  default_tls_client_key_type = "ec"
+  default_tls_client_key_bits = 256
+  default_tls_client_ttl      = 86400
+}
+`, path, listenAddr, caKeyBits)
+}
+
+func testKMIPSecretBackendRoleConfig(path, operations string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path         = "%s"
+  listen_addrs = ["127.0.0.1:5698"]
+}
+
+resource "vault_kmip_secret_backend_scope" "test" {
+  backend = vault_kmip_secret_backend.test.path
+  scope   = "scope-1"
+  force   = true
+}
+
+resource "vault_kmip_secret_backend_role" "test" {
+  backend             = vault_kmip_secret_backend.test.path
+  scope               = vault_kmip_secret_backend_scope.test.scope
+  role                = "role-1"
+  tls_client_key_type = "ec"
+  tls_client_key_bits = 256
+%s
+}
+`, path, operations)
+}
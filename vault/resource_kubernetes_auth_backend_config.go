@@ -117,11 +117,11 @@ func kubernetesAuthBackendConfigCreate(d *schema.ResourceData, meta interface{})
 		data["issuer"] = v.(string)
 	}
 
-	if v, ok := d.GetOk("disable_iss_validation"); ok {
+	if v, ok := d.GetOkExists("disable_iss_validation"); ok {
 		data["disable_iss_validation"] = v
 	}
 
-	if v, ok := d.GetOk("disable_local_ca_jwt"); ok {
+	if v, ok := d.GetOkExists("disable_local_ca_jwt"); ok {
 		data["disable_local_ca_jwt"] = v
 	}
 	_, err := client.Logical().Write(path, data)
@@ -219,12 +219,14 @@ func kubernetesAuthBackendConfigUpdate(d *schema.ResourceData, meta interface{})
 		data["issuer"] = v.(string)
 	}
 
-	if v, ok := d.GetOk("disable_iss_validation"); ok {
-		data["disable_iss_validation"] = v
+	// GetOk ignores false, so changes are sent explicitly to allow
+	// setting these back to false.
+	if d.HasChange("disable_iss_validation") {
+		data["disable_iss_validation"] = d.Get("disable_iss_validation")
 	}
 
-	if v, ok := d.GetOk("disable_local_ca_jwt"); ok {
-		data["disable_local_ca_jwt"] = v
+	if d.HasChange("disable_local_ca_jwt") {
+		data["disable_local_ca_jwt"] = d.Get("disable_local_ca_jwt")
 	}
 
 	_, err := client.Logical().Write(path, data)
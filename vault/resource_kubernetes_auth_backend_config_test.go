@@ -314,6 +314,15 @@ func TestAccKubernetesAuthBackendConfig_fullUpdate(t *testing.T) {
 						"disable_local_ca_jwt", strconv.FormatBool(newDisableLocalCaJwt)),
 				),
 			},
+			{
+				Config: testAccKubernetesAuthBackendConfigConfig_full(backend, newJWT, newIssuer, oldDisableIssValidation, oldDisableLocalCaJwt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_config.config",
+						"disable_iss_validation", strconv.FormatBool(oldDisableIssValidation)),
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_config.config",
+						"disable_local_ca_jwt", strconv.FormatBool(oldDisableLocalCaJwt)),
+				),
+			},
 		},
 	})
 }
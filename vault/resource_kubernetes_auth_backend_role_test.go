@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"strconv"
@@ -496,6 +497,44 @@ func TestAccKubernetesAuthBackendRole_fullDeprecated(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesAuthBackendRole_tokenBoundCidrs(t *testing.T) {
+	backend := acctest.RandomWithPrefix("kubernetes")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckKubernetesAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesAuthBackendRoleConfig_tokenBoundCidrs(backend, role, `["10.0.0.0/8", "192.168.1.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
+						"token_bound_cidrs.#", "2"),
+				),
+			},
+			{
+				Config: testAccKubernetesAuthBackendRoleConfig_tokenBoundCidrs(backend, role, `["10.0.0.0/8"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
+						"token_bound_cidrs.#", "1"),
+				),
+			},
+			{
+				Config: testAccKubernetesAuthBackendRoleConfig_tokenBoundCidrs(backend, role, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
+						"token_bound_cidrs.#", "0"),
+				),
+			},
+			{
+				Config:      testAccKubernetesAuthBackendRoleConfig_tokenBoundCidrs(backend, role, `["10.0.0.0/33"]`),
+				ExpectError: regexp.MustCompile("expected token_bound_cidrs.* to be a valid CIDR block or IP address"),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesAuthBackendRoleDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -569,6 +608,22 @@ resource "vault_kubernetes_auth_backend_role" "role" {
 }`, backend, role, ttl, maxTTL, audience)
 }
 
+func testAccKubernetesAuthBackendRoleConfig_tokenBoundCidrs(backend, role, cidrs string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "kubernetes" {
+  type = "kubernetes"
+  path = %q
+}
+
+resource "vault_kubernetes_auth_backend_role" "role" {
+  backend = "${vault_auth_backend.kubernetes.path}"
+  role_name = %q
+  bound_service_account_names = ["example"]
+  bound_service_account_namespaces = ["example"]
+  token_bound_cidrs = %s
+}`, backend, role, cidrs)
+}
+
 func testAccKubernetesAuthBackendRoleConfig_fullDeprecated(backend, role string, ttl, maxTTL int, audience string) string {
 	return fmt.Sprintf(`
 resource "vault_auth_backend" "kubernetes" {
@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kvSecretWrite,
+		Read:   kvSecretRead,
+		Update: kvSecretWrite,
+		Delete: kvSecretDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Full path of the KV-V1 secret, including the mount.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"data_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "JSON-encoded secret data to write.",
+				StateFunc:    NormalizeDataJSON,
+				ValidateFunc: ValidateDataJSON,
+				Sensitive:    true,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of strings read from Vault.",
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func kvSecretWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("data_json").(string)), &data); err != nil {
+		return fmt.Errorf("data_json %#v syntax error: %s", d.Get("data_json"), err)
+	}
+
+	path := strings.Trim(d.Get("path").(string), "/")
+
+	log.Printf("[DEBUG] Writing KV-V1 secret to %s", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KV-V1 secret to %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV-V1 secret to %s", path)
+
+	d.SetId(path)
+
+	return kvSecretRead(d, meta)
+}
+
+func kvSecretRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading KV-V1 secret from %s", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V1 secret from %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KV-V1 secret from %s", path)
+	if secret == nil {
+		log.Printf("[WARN] KV-V1 secret %s not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	jsonData, err := json.Marshal(secret.Data)
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+	}
+
+	d.Set("path", path)
+	d.Set("data_json", string(jsonData))
+	if err := d.Set("data", flattenSecretData(secret.Data)); err != nil {
+		return fmt.Errorf("error setting data for %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func kvSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting KV-V1 secret %s", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting KV-V1 secret %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KV-V1 secret %s", path)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretBackendV2Resource() *schema.Resource {
+	return &schema.Resource{
+		Create: kvSecretBackendV2Create,
+		Read:   kvSecretBackendV2Read,
+		Update: kvSecretBackendV2Update,
+		Delete: kvSecretBackendV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the KV-V2 engine is mounted.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"max_versions": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of versions to keep per key, 0 to keep the Vault default of 10.",
+			},
+			"cas_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, all keys will require the cas parameter to be set on all write requests.",
+			},
+			"delete_version_after": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "If set, specifies the length of time before a version is deleted. Accepts duration in integer seconds.",
+			},
+		},
+	}
+}
+
+func kvSecretBackendV2Create(d *schema.ResourceData, meta interface{}) error {
+	mount := d.Get("mount").(string)
+
+	d.SetId(kvSecretBackendV2Path(mount))
+
+	return kvSecretBackendV2Update(d, meta)
+}
+
+func kvSecretBackendV2Update(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"max_versions":         d.Get("max_versions").(int),
+		"cas_required":         d.Get("cas_required").(bool),
+		"delete_version_after": d.Get("delete_version_after").(int),
+	}
+
+	log.Printf("[DEBUG] Writing KV-V2 config to %s", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KV-V2 config to %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV-V2 config to %s", path)
+
+	return kvSecretBackendV2Read(d, meta)
+}
+
+func kvSecretBackendV2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	if !strings.HasSuffix(path, "/config") {
+		return fmt.Errorf("invalid ID %q, expected <mount>/config", path)
+	}
+
+	log.Printf("[DEBUG] Reading KV-V2 config from %s", path)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 config from %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KV-V2 config from %s", path)
+	if config == nil {
+		log.Printf("[WARN] KV-V2 config %s not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// The engine config holds the same settings as the secret metadata.
+	m, err := flattenKVSecretV2Metadata(config.Data)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 config from %s: %s", path, err)
+	}
+
+	d.Set("mount", strings.TrimSuffix(path, "/config"))
+	for _, k := range []string{"max_versions", "cas_required", "delete_version_after"} {
+		if err := d.Set(k, m[k]); err != nil {
+			return fmt.Errorf("error setting state key %q for %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func kvSecretBackendV2Delete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func kvSecretBackendV2Path(mount string) string {
+	return strings.Trim(mount, "/") + "/config"
+}
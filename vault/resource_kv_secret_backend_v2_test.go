@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceKVSecretBackendV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	resName := "vault_kv_secret_backend_v2.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceKVSecretBackendV2_config(mount, 5, true, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", mount+"/config"),
+					resource.TestCheckResourceAttr(resName, "mount", mount),
+					resource.TestCheckResourceAttr(resName, "max_versions", "5"),
+					resource.TestCheckResourceAttr(resName, "cas_required", "true"),
+					resource.TestCheckResourceAttr(resName, "delete_version_after", "3600"),
+				),
+			},
+			{
+				Config: testResourceKVSecretBackendV2_config(mount, 10, false, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "max_versions", "10"),
+					resource.TestCheckResourceAttr(resName, "cas_required", "false"),
+					resource.TestCheckResourceAttr(resName, "delete_version_after", "0"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceKVSecretBackendV2_config(mount string, maxVersions int, casRequired bool, deleteVersionAfter int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path = "%s"
+  type = "kv-v2"
+}
+
+resource "vault_kv_secret_backend_v2" "test" {
+  mount                = vault_mount.kvv2.path
+  max_versions         = %d
+  cas_required         = %t
+  delete_version_after = %d
+}
+`, mount, maxVersions, casRequired, deleteVersionAfter)
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceKVSecret(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kv")
+	name := acctest.RandomWithPrefix("foo")
+	resName := "vault_kv_secret.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceKVSecretCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceKVSecret_config(mount, name, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", mount+"/"+name),
+					resource.TestCheckResourceAttr(resName, "data.%", "2"),
+					resource.TestCheckResourceAttr(resName, "data.foo", "bar"),
+					resource.TestCheckResourceAttr(resName, "data.nested", `{"zip":"zap"}`),
+				),
+			},
+			{
+				Config: testResourceKVSecret_config(mount, name, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "data.foo", "baz"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceKVSecretCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kv_secret" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			// The mount has been removed along with the secret.
+			continue
+		}
+		if secret != nil {
+			return fmt.Errorf("KV-V1 secret %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourceKVSecret_config(mount, name, value string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv1" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "1"
+  }
+}
+
+resource "vault_kv_secret" "test" {
+  path      = "${vault_mount.kvv1.path}/%s"
+  data_json = jsonencode({
+    foo    = "%s"
+    nested = {
+      zip = "zap"
+    }
+  })
+}
+`, mount, name, value)
+}
@@ -0,0 +1,345 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretV2Path(mount, name, apiPrefix string) string {
+	return strings.Trim(mount, "/") + "/" + apiPrefix + "/" + strings.Trim(name, "/")
+}
+
+func kvSecretV2ParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/data/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID %q, expected <mount>/data/<name>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func kvSecretV2Resource() *schema.Resource {
+	return &schema.Resource{
+		Create: kvSecretV2Create,
+		Read:   kvSecretV2Read,
+		Update: kvSecretV2Update,
+		Delete: kvSecretV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the KV-V2 engine is mounted.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Full name of the secret. For a nested secret, the name is the nested path excluding the mount and data prefix.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secret will be written.",
+			},
+			"data_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"data", "data_json"},
+				Description:      "JSON-encoded secret data to write. Value types are preserved.",
+				ValidateFunc:     ValidateDataJSON,
+				DiffSuppressFunc: util.JsonDiffSuppress,
+				Sensitive:        true,
+			},
+			"data": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"data", "data_json"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Map of strings to write as the secret data, or read from Vault.",
+				Sensitive:    true,
+			},
+			"delete_all_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to true, permanently deletes all versions and the metadata of the secret on destroy.",
+			},
+			"custom_metadata": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Custom metadata to be set for the secret.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_versions": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The number of versions to keep per key.",
+						},
+						"cas_required": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "If true, all keys will require the cas parameter to be set on all write requests.",
+						},
+						"delete_version_after": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "If set, specifies the length of time before a version is deleted. Accepts duration in integer seconds.",
+						},
+						"data": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "A map of arbitrary string to string valued user-provided metadata meant to describe the secret.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kvSecretV2Create(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := d.Get("mount").(string)
+	name := d.Get("name").(string)
+
+	if err := kvSecretV2WriteData(d, client); err != nil {
+		return err
+	}
+
+	d.SetId(kvSecretV2Path(mount, name, "data"))
+
+	if _, ok := d.GetOk("custom_metadata"); ok {
+		if err := kvSecretV2WriteMetadata(d, client); err != nil {
+			return err
+		}
+	}
+
+	return kvSecretV2Read(d, meta)
+}
+
+func kvSecretV2Update(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// Only write the data when it has changed, otherwise a new version of
+	// the secret would be created on every metadata update.
+	if d.HasChange("data_json") || d.HasChange("data") {
+		if err := kvSecretV2WriteData(d, client); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("custom_metadata") {
+		if err := kvSecretV2WriteMetadata(d, client); err != nil {
+			return err
+		}
+	}
+
+	return kvSecretV2Read(d, meta)
+}
+
+func kvSecretV2WriteData(d *schema.ResourceData, client *api.Client) error {
+	data, err := kvSecretV2ConfigData(d)
+	if err != nil {
+		return err
+	}
+
+	path := kvSecretV2Path(d.Get("mount").(string), d.Get("name").(string), "data")
+
+	log.Printf("[DEBUG] Writing KV-V2 secret to %s", path)
+	_, err = client.Logical().Write(path, map[string]interface{}{
+		"data":    data,
+		"options": map[string]interface{}{},
+	})
+	if err != nil {
+		return fmt.Errorf("error writing KV-V2 secret to %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV-V2 secret to %s", path)
+
+	return nil
+}
+
+// kvSecretV2ConfigData returns the secret data to write from whichever of
+// data or data_json is set in the configuration. Both are also computed from
+// the secret read back from Vault, so on update only the configured one can
+// have changed.
+func kvSecretV2ConfigData(d *schema.ResourceData) (map[string]interface{}, error) {
+	var useData bool
+	if d.Id() == "" {
+		_, useData = d.GetOk("data")
+	} else {
+		useData = !d.HasChange("data_json")
+	}
+	if useData {
+		return d.Get("data").(map[string]interface{}), nil
+	}
+
+	var data map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(d.Get("data_json").(string)))
+	// Keep numbers as they were written instead of converting them to
+	// float64 and losing precision.
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, fmt.Errorf("data_json %#v syntax error: %s", d.Get("data_json"), err)
+	}
+
+	return data, nil
+}
+
+func kvSecretV2WriteMetadata(d *schema.ResourceData, client *api.Client) error {
+	path := kvSecretV2Path(d.Get("mount").(string), d.Get("name").(string), "metadata")
+
+	data := map[string]interface{}{
+		"max_versions":         d.Get("custom_metadata.0.max_versions").(int),
+		"cas_required":         d.Get("custom_metadata.0.cas_required").(bool),
+		"delete_version_after": d.Get("custom_metadata.0.delete_version_after").(int),
+		"custom_metadata":      d.Get("custom_metadata.0.data").(map[string]interface{}),
+	}
+
+	log.Printf("[DEBUG] Writing KV-V2 metadata to %s", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KV-V2 metadata to %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV-V2 metadata to %s", path)
+
+	return nil
+}
+
+func kvSecretV2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	mount, name, err := kvSecretV2ParseID(path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading KV-V2 secret from %s", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 secret from %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KV-V2 secret from %s", path)
+
+	var data map[string]interface{}
+	if secret != nil {
+		data, _ = secret.Data["data"].(map[string]interface{})
+	}
+	if data == nil {
+		log.Printf("[WARN] KV-V2 secret %s not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+	}
+
+	d.Set("mount", mount)
+	d.Set("name", name)
+	d.Set("path", path)
+	d.Set("data_json", string(jsonData))
+	if err := d.Set("data", flattenSecretData(data)); err != nil {
+		return fmt.Errorf("error setting data for %q: %s", path, err)
+	}
+
+	metadataPath := kvSecretV2Path(mount, name, "metadata")
+
+	log.Printf("[DEBUG] Reading KV-V2 metadata from %s", metadataPath)
+	metadata, err := client.Logical().Read(metadataPath)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 metadata from %s: %s", metadataPath, err)
+	}
+	log.Printf("[DEBUG] Read KV-V2 metadata from %s", metadataPath)
+
+	if metadata != nil {
+		customMetadata, err := flattenKVSecretV2Metadata(metadata.Data)
+		if err != nil {
+			return fmt.Errorf("error reading KV-V2 metadata from %s: %s", metadataPath, err)
+		}
+		if err := d.Set("custom_metadata", []map[string]interface{}{customMetadata}); err != nil {
+			return fmt.Errorf("error setting custom_metadata for %q: %s", path, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenKVSecretV2Metadata(data map[string]interface{}) (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"max_versions":         0,
+		"cas_required":         false,
+		"delete_version_after": 0,
+		"data":                 map[string]interface{}{},
+	}
+
+	if v, ok := data["max_versions"].(json.Number); ok {
+		i, err := v.Int64()
+		if err != nil {
+			return nil, err
+		}
+		m["max_versions"] = int(i)
+	}
+
+	if v, ok := data["cas_required"].(bool); ok {
+		m["cas_required"] = v
+	}
+
+	if v, ok := data["delete_version_after"].(string); ok && v != "" {
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		m["delete_version_after"] = int(dur.Seconds())
+	}
+
+	if v, ok := data["custom_metadata"].(map[string]interface{}); ok {
+		m["data"] = v
+	}
+
+	return m, nil
+}
+
+func kvSecretV2Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	if d.Get("delete_all_versions").(bool) {
+		mount, name, err := kvSecretV2ParseID(path)
+		if err != nil {
+			return err
+		}
+		path = kvSecretV2Path(mount, name, "metadata")
+	}
+
+	log.Printf("[DEBUG] Deleting KV-V2 secret %s", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting KV-V2 secret %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KV-V2 secret %s", path)
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceKVSecretV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+	resName := "vault_kv_secret_v2.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceKVSecretV2CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceKVSecretV2_config(mount, name, "bar", 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "mount", mount),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "path", mount+"/data/"+name),
+					resource.TestCheckResourceAttr(resName, "data.foo", "bar"),
+					resource.TestCheckResourceAttr(resName, "custom_metadata.#", "1"),
+					resource.TestCheckResourceAttr(resName, "custom_metadata.0.max_versions", "5"),
+					resource.TestCheckResourceAttr(resName, "custom_metadata.0.cas_required", "false"),
+					resource.TestCheckResourceAttr(resName, "custom_metadata.0.delete_version_after", "3600"),
+					resource.TestCheckResourceAttr(resName, "custom_metadata.0.data.owner", "team-a"),
+					testResourceKVSecretV2CheckCurrentVersion(mount, name, 1),
+				),
+			},
+			{
+				// Only the metadata changes, a new version of the secret must not be written.
+				Config: testResourceKVSecretV2_config(mount, name, "bar", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "data.foo", "bar"),
+					resource.TestCheckResourceAttr(resName, "custom_metadata.0.max_versions", "10"),
+					testResourceKVSecretV2CheckCurrentVersion(mount, name, 1),
+				),
+			},
+			{
+				Config: testResourceKVSecretV2_config(mount, name, "baz", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "data.foo", "baz"),
+					testResourceKVSecretV2CheckCurrentVersion(mount, name, 2),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_all_versions"},
+			},
+		},
+	})
+}
+
+func TestResourceKVSecretV2_deleteAllVersions(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceKVSecretV2_deleteAllVersionsConfig(mount, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_kv_secret_v2.test", "delete_all_versions", "true"),
+					testResourceKVSecretV2CheckCurrentVersion(mount, name, 1),
+				),
+			},
+			{
+				Config: testResourceKVSecretV2_deleteAllVersionsConfig(mount, name, false),
+				Check:  testResourceKVSecretV2CheckMetadataDeleted(mount, name),
+			},
+		},
+	})
+}
+
+func TestResourceKVSecretV2_dataTypes(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+	resName := "vault_kv_secret_v2.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceKVSecretV2CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceKVSecretV2_dataJSONConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "data.count", "3"),
+					resource.TestCheckResourceAttr(resName, "data.enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "data.name", "bar"),
+					resource.TestCheckResourceAttr(resName, "data_json", `{"count":3,"enabled":true,"name":"bar"}`),
+					testResourceKVSecretV2CheckCurrentVersion(mount, name, 1),
+				),
+			},
+			{
+				Config: testResourceKVSecretV2_dataMapConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "data.%", "2"),
+					resource.TestCheckResourceAttr(resName, "data.count", "4"),
+					resource.TestCheckResourceAttr(resName, "data.name", "baz"),
+					resource.TestCheckResourceAttr(resName, "data_json", `{"count":"4","name":"baz"}`),
+					testResourceKVSecretV2CheckCurrentVersion(mount, name, 2),
+				),
+			},
+		},
+	})
+}
+
+func testResourceKVSecretV2_dataJSONConfig(mount, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path = "%s"
+  type = "kv-v2"
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  data_json = <<EOT
+{
+  "name":    "bar",
+  "enabled": true,
+  "count":   3
+}
+EOT
+}
+`, mount, name)
+}
+
+func testResourceKVSecretV2_dataMapConfig(mount, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path = "%s"
+  type = "kv-v2"
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount = vault_mount.kvv2.path
+  name  = "%s"
+  data = {
+    name  = "baz"
+    count = "4"
+  }
+}
+`, mount, name)
+}
+
+func testResourceKVSecretV2CheckMetadataDeleted(mount, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		path := kvSecretV2Path(mount, name, "metadata")
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading %q: %s", path, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("expected all versions of %q to be deleted, got %#v", path, resp.Data["versions"])
+		}
+
+		return nil
+	}
+}
+
+func testResourceKVSecretV2_deleteAllVersionsConfig(mount, name string, withSecret bool) string {
+	config := fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path = "%s"
+  type = "kv-v2"
+}
+`, mount)
+
+	if withSecret {
+		config += fmt.Sprintf(`
+resource "vault_kv_secret_v2" "test" {
+  mount               = vault_mount.kvv2.path
+  name                = "%s"
+  delete_all_versions = true
+  data_json = jsonencode({
+    foo = "bar"
+  })
+}
+`, name)
+	}
+
+	return config
+}
+
+func testResourceKVSecretV2CheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kv_secret_v2" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			// The mount has been removed along with the secret.
+			continue
+		}
+		if secret != nil && secret.Data["data"] != nil {
+			return fmt.Errorf("KV-V2 secret %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourceKVSecretV2CheckCurrentVersion(mount, name string, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		path := kvSecretV2Path(mount, name, "metadata")
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading %q: %s", path, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("metadata %q not found", path)
+		}
+
+		actual, err := resp.Data["current_version"].(json.Number).Int64()
+		if err != nil {
+			return err
+		}
+		if actual != expected {
+			return fmt.Errorf("expected current_version %d, got %d", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testResourceKVSecretV2_config(mount, name, value string, maxVersions int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path = "%s"
+  type = "kv-v2"
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount     = vault_mount.kvv2.path
+  name      = "%s"
+  data_json = jsonencode({
+    foo = "%s"
+  })
+
+  custom_metadata {
+    max_versions         = %d
+    delete_version_after = 3600
+    data = {
+      owner = "team-a"
+    }
+  }
+}
+`, mount, name, value, maxVersions)
+}
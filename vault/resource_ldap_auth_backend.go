@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/hashicorp/vault/api"
 )
@@ -67,6 +68,18 @@ func ldapAuthBackendResource() *schema.Resource {
 				return strings.ToLower(v.(string))
 			},
 		},
+		"userfilter": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Go template used to construct the LDAP user search filter.",
+		},
+		"username_as_alias": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Force the auth method to use the username passed by the user as the alias name.",
+		},
 		"discoverdn": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -103,6 +116,21 @@ func ldapAuthBackendResource() *schema.Resource {
 			Computed: true,
 		},
 
+		"connection_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Timeout, in seconds, when attempting to connect to the LDAP server before trying the next URL in the configuration.",
+		},
+		"request_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Timeout, in seconds, for the connection when making requests against the server before returning back an error.",
+		},
+
 		"description": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -223,6 +251,14 @@ func ldapAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["userattr"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("userfilter"); ok {
+		data["userfilter"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("username_as_alias"); ok {
+		data["username_as_alias"] = v.(bool)
+	}
+
 	if v, ok := d.GetOkExists("discoverdn"); ok {
 		data["discoverdn"] = v.(bool)
 	}
@@ -251,6 +287,14 @@ func ldapAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["use_token_groups"] = v.(bool)
 	}
 
+	if v, ok := d.GetOk("connection_timeout"); ok {
+		data["connection_timeout"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("request_timeout"); ok {
+		data["request_timeout"] = v.(int)
+	}
+
 	if v, ok := d.GetOk("client_tls_cert"); ok {
 		data["client_tls_cert"] = v.(string)
 	}
@@ -328,6 +372,20 @@ func ldapAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("groupattr", resp.Data["groupattr"])
 	d.Set("use_token_groups", resp.Data["use_token_groups"])
 
+	// only returned by the Vault versions that support them
+	if v, ok := resp.Data["userfilter"]; ok {
+		d.Set("userfilter", v)
+	}
+	if v, ok := resp.Data["username_as_alias"]; ok {
+		d.Set("username_as_alias", v)
+	}
+	if v, ok := resp.Data["connection_timeout"]; ok {
+		d.Set("connection_timeout", v)
+	}
+	if v, ok := resp.Data["request_timeout"]; ok {
+		d.Set("request_timeout", v)
+	}
+
 	// `bindpass`, `client_tls_cert` and `client_tls_key` cannot be read out from the API
 	// So... if they drift, they drift.
 
@@ -59,6 +59,29 @@ func TestLDAPAuthBackend_basic(t *testing.T) {
 	})
 }
 
+func TestLDAPAuthBackend_userfilter(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-ldap-path")
+	userfilter := "(&(objectClass=user)({{.UserAttr}}={{.Username}}))"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testLDAPAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPAuthBackendConfig_userfilter(path, userfilter),
+				Check: resource.ComposeTestCheckFunc(
+					testLDAPAuthBackendCheck_attrs(path),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend.test", "userfilter", userfilter),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend.test", "username_as_alias", "true"),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend.test", "connection_timeout", "15"),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend.test", "request_timeout", "30"),
+				),
+			},
+		},
+	})
+}
+
 func TestLDAPAuthBackend_tls(t *testing.T) {
 	path := acctest.RandomWithPrefix("tf-test-ldap-tls-path")
 
@@ -177,6 +200,12 @@ func testLDAPAuthBackendCheck_attrs(path string) resource.TestCheckFunc {
 			"groupattr":        "groupattr",
 			"use_token_groups": "use_token_groups",
 		}
+		// only returned by the Vault versions that support them
+		for _, k := range []string{"userfilter", "username_as_alias", "connection_timeout", "request_timeout"} {
+			if _, ok := resp.Data[k]; ok {
+				attrs[k] = k
+			}
+		}
 
 		for stateAttr, apiAttr := range attrs {
 			if resp.Data[apiAttr] == nil && instanceState.Attributes[stateAttr] == "" {
@@ -265,6 +294,20 @@ resource "vault_ldap_auth_backend" "test" {
 
 }
 
+func testLDAPAuthBackendConfig_userfilter(path, userfilter string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_auth_backend" "test" {
+    path               = "%s"
+    url                = "ldaps://example.org"
+    userattr           = "uid"
+    userfilter         = "%s"
+    username_as_alias  = true
+    connection_timeout = 15
+    request_timeout    = 30
+}
+`, path, userfilter)
+}
+
 func testLDAPAuthBackendConfig_tls(path, use_token_groups string) string {
 
 	return fmt.Sprintf(`
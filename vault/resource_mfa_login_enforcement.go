@@ -0,0 +1,156 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const mfaLoginEnforcementPath = "identity/mfa/login-enforcement"
+
+var (
+	// mfaLoginEnforcementTargetFields are the fields selecting what the
+	// enforcement applies to, at least one of them must be set.
+	mfaLoginEnforcementTargetFields = []string{
+		"auth_method_accessors",
+		"auth_method_types",
+		"identity_group_ids",
+		"identity_entity_ids",
+	}
+	mfaLoginEnforcementFields = append([]string{"mfa_method_ids"}, mfaLoginEnforcementTargetFields...)
+)
+
+func mfaLoginEnforcementResource() *schema.Resource {
+	return &schema.Resource{
+		Create:        mfaLoginEnforcementWrite,
+		Update:        mfaLoginEnforcementWrite,
+		Read:          mfaLoginEnforcementRead,
+		Delete:        mfaLoginEnforcementDelete,
+		CustomizeDiff: mfaLoginEnforcementDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the login enforcement.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"mfa_method_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the MFA methods that must be passed to login.",
+			},
+			"auth_method_accessors": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Accessors of the auth mounts the enforcement applies to.",
+			},
+			"auth_method_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Types of the auth methods the enforcement applies to.",
+			},
+			"identity_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the identity groups the enforcement applies to.",
+			},
+			"identity_entity_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the identity entities the enforcement applies to.",
+			},
+		},
+	}
+}
+
+func mfaLoginEnforcementDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range mfaLoginEnforcementTargetFields {
+		// values that are only known after apply can't be checked yet
+		if !d.NewValueKnown(k) || d.Get(k).(*schema.Set).Len() > 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("at least one of %s must be set", strings.Join(mfaLoginEnforcementTargetFields, ", "))
+}
+
+func mfaLoginEnforcementWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := mfaLoginEnforcementNamePath(name)
+
+	data := map[string]interface{}{}
+	for _, k := range mfaLoginEnforcementFields {
+		data[k] = d.Get(k).(*schema.Set).List()
+	}
+
+	log.Printf("[DEBUG] Writing MFA login enforcement %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing MFA login enforcement %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote MFA login enforcement %q", path)
+
+	d.SetId(name)
+
+	return mfaLoginEnforcementRead(d, meta)
+}
+
+func mfaLoginEnforcementRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+	path := mfaLoginEnforcementNamePath(name)
+
+	log.Printf("[DEBUG] Reading MFA login enforcement %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading MFA login enforcement %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] MFA login enforcement %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range mfaLoginEnforcementFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on MFA login enforcement %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func mfaLoginEnforcementDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := mfaLoginEnforcementNamePath(d.Id())
+
+	log.Printf("[DEBUG] Deleting MFA login enforcement %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting MFA login enforcement %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted MFA login enforcement %q", path)
+
+	return nil
+}
+
+func mfaLoginEnforcementNamePath(name string) string {
+	return fmt.Sprintf("%s/%s", mfaLoginEnforcementPath, strings.Trim(name, "/"))
+}
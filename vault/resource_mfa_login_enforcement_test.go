@@ -0,0 +1,87 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccMFALoginEnforcement(t *testing.T) {
+	name := acctest.RandomWithPrefix("enforcement")
+	resourceName := "vault_mfa_login_enforcement.enforcement"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckMFALoginEnforcementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMFALoginEnforcementConfig(name, `auth_method_types = ["userpass"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "mfa_method_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_accessors.#", "0"),
+				),
+			},
+			{
+				Config: testAccMFALoginEnforcementConfig(name, `auth_method_accessors = [vault_auth_backend.userpass.accessor]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_types.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_accessors.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccMFALoginEnforcementConfig(name, ""),
+				ExpectError: regexp.MustCompile("at least one of auth_method_accessors"),
+			},
+		},
+	})
+}
+
+func testAccCheckMFALoginEnforcementDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_mfa_login_enforcement" {
+			continue
+		}
+		resp, err := client.Logical().Read(mfaLoginEnforcementNamePath(rs.Primary.ID))
+		if err != nil {
+			return fmt.Errorf("error checking for MFA login enforcement %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("MFA login enforcement %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccMFALoginEnforcementConfig(name, targets string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_identity_mfa_totp" "totp" {
+  issuer = "%s"
+}
+
+resource "vault_mfa_login_enforcement" "enforcement" {
+  name           = "%s"
+  mfa_method_ids = [vault_identity_mfa_totp.totp.method_id]
+  %s
+}`, name, name, name, targets)
+}
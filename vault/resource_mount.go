@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -19,96 +20,157 @@ func MountResource() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"path": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    false,
-				Description: "Where the secret backend will be mounted",
-			},
+		Schema: getMountSchema(),
+	}
+}
 
-			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Type of the backend, such as 'aws'",
-			},
+// getMountSchema returns the schema of the vault_mount resource without the
+// given fields.
+func getMountSchema(excludes ...string) map[string]*schema.Schema {
+	s := mountSchema()
+	for _, k := range excludes {
+		delete(s, k)
+	}
+	return s
+}
 
-			"description": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Required:    false,
-				ForceNew:    false,
-				Description: "Human-friendly description of the mount",
-			},
+func mountSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    false,
+			Description: "Where the secret backend will be mounted",
+		},
 
-			"default_lease_ttl_seconds": {
-				Type:        schema.TypeInt,
-				Required:    false,
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    false,
-				Description: "Default lease duration for tokens and secrets in seconds",
+		"type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Type of the backend, such as 'aws'",
+			// An imported kv-v2 mount is reported by Vault as "kv", version 2.
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				o, _ := d.GetChange("options")
+				version := o.(map[string]interface{})["version"]
+				return old == "kv" && new == "kv-v2" && version != nil && normalizeMountVersion(version.(string)) == "2"
 			},
+		},
 
-			"max_lease_ttl_seconds": {
-				Type:        schema.TypeInt,
-				Required:    false,
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    false,
-				Description: "Maximum possible lease duration for tokens and secrets in seconds",
-			},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Required:    false,
+			ForceNew:    false,
+			Description: "Human-friendly description of the mount",
+		},
 
-			"accessor": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Accessor of the mount",
-			},
+		"default_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Required:    false,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    false,
+			Description: "Default lease duration for tokens and secrets in seconds",
+		},
 
-			"local": {
-				Type:        schema.TypeBool,
-				Required:    false,
-				Optional:    true,
-				Computed:    false,
-				ForceNew:    true,
-				Description: "Local mount flag that can be explicitly set to true to enforce local mount in HA environment",
-			},
+		"max_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Required:    false,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    false,
+			Description: "Maximum possible lease duration for tokens and secrets in seconds",
+		},
 
-			"options": {
-				Type:        schema.TypeMap,
-				Required:    false,
-				Optional:    true,
-				Computed:    false,
-				ForceNew:    false,
-				Description: "Specifies mount type specific options that are passed to the backend",
-			},
+		"accessor": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Accessor of the mount",
+		},
 
-			"seal_wrap": {
-				Type:        schema.TypeBool,
-				Required:    false,
-				Optional:    true,
-				ForceNew:    true,
-				Computed:    true,
-				Description: "Enable seal wrapping for the mount, causing values stored by the mount to be wrapped by the seal's encryption capability",
-			},
+		"local": {
+			Type:        schema.TypeBool,
+			Required:    false,
+			Optional:    true,
+			Computed:    false,
+			ForceNew:    true,
+			Description: "Local mount flag that can be explicitly set to true to enforce local mount in HA environment",
+		},
 
-			"external_entropy_access": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				ForceNew:    true,
-				Description: "Enable the secrets engine to access Vault's external entropy source",
-			},
+		"options": {
+			Type:             schema.TypeMap,
+			Required:         false,
+			Optional:         true,
+			Computed:         false,
+			ForceNew:         false,
+			Description:      "Specifies mount type specific options that are passed to the backend",
+			DiffSuppressFunc: mountOptionsDiffSuppress,
+		},
+
+		"seal_wrap": {
+			Type:        schema.TypeBool,
+			Required:    false,
+			Optional:    true,
+			ForceNew:    true,
+			Computed:    true,
+			Description: "Enable seal wrapping for the mount, causing values stored by the mount to be wrapped by the seal's encryption capability",
+		},
+
+		"external_entropy_access": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+			Description: "Enable the secrets engine to access Vault's external entropy source",
+		},
+
+		"allowed_managed_keys": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "List of managed key registry entry names that the mount in question is allowed to access",
+		},
+
+		"delegated_auth_accessors": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "List of auth backend accessors that the mount is allowed to delegate authentication to, requires Vault 1.15+",
+		},
+
+		"plugin_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Version of the registered plugin to run the mount with, requires Vault 1.12+",
 		},
 	}
 }
 
+// mountInput extends api.MountInput with the fields the API client doesn't
+// support yet.
+type mountInput struct {
+	*api.MountInput
+	PluginVersion string `json:"plugin_version,omitempty"`
+}
+
 func mountWrite(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
+	path := d.Get("path").(string)
+	if err := createMount(d, client, path, d.Get("type").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	return mountRead(d, meta)
+}
+
+func createMount(d *schema.ResourceData, client *api.Client, path string, mountType string) error {
 	info := &api.MountInput{
-		Type:        d.Get("type").(string),
+		Type:        mountType,
 		Description: d.Get("description").(string),
 		Config: api.MountConfigInput{
 			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
@@ -120,22 +182,41 @@ func mountWrite(d *schema.ResourceData, meta interface{}) error {
 		ExternalEntropyAccess: d.Get("external_entropy_access").(bool),
 	}
 
-	path := d.Get("path").(string)
-
 	log.Printf("[DEBUG] Creating mount %s in Vault", path)
 
-	if err := client.Sys().Mount(path, info); err != nil {
+	r := client.NewRequest("POST", "/v1/sys/mounts/"+strings.Trim(path, "/"))
+	if err := r.SetJSONBody(&mountInput{
+		MountInput:    info,
+		PluginVersion: d.Get("plugin_version").(string),
+	}); err != nil {
+		return err
+	}
+	resp, err := client.RawRequest(r)
+	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
+	resp.Body.Close()
 
-	d.SetId(path)
+	for _, k := range []string{"allowed_managed_keys", "delegated_auth_accessors"} {
+		if v, ok := d.GetOk(k); ok {
+			if err := mountTuneStringList(client, path, k, v.(*schema.Set).List()); err != nil {
+				return err
+			}
+		}
+	}
 
-	return mountRead(d, meta)
+	return nil
 }
 
 func mountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	if err := updateMount(d, meta.(*api.Client)); err != nil {
+		return err
+	}
 
+	return mountRead(d, meta)
+}
+
+func updateMount(d *schema.ResourceData, client *api.Client) error {
 	config := api.MountConfigInput{
 		DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
 		MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
@@ -169,7 +250,23 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error updating Vault: %s", err)
 	}
 
-	return mountRead(d, meta)
+	for _, k := range []string{"allowed_managed_keys", "delegated_auth_accessors"} {
+		// the parameter is left alone when it is not set, so that values
+		// set outside of Terraform are kept.
+		if v, ok := d.GetOk(k); ok && d.HasChange(k) {
+			if err := mountTuneStringList(client, path, k, v.(*schema.Set).List()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("plugin_version") {
+		if err := mountTunePluginVersion(client, path, d.Get("plugin_version").(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func mountDelete(d *schema.ResourceData, meta interface{}) error {
@@ -187,8 +284,12 @@ func mountDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func mountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	return readMount(d, meta.(*api.Client), false)
+}
 
+// readMount reads the mount at d.Id() into d, excludeType is used by
+// resources that only manage a single mount type and have no type field.
+func readMount(d *schema.ResourceData, client *api.Client, excludeType bool) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Reading mount %s from Vault", path)
@@ -208,32 +309,98 @@ func mountRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	cfgType := d.Get("type").(string)
+	if !excludeType {
+		cfgType := d.Get("type").(string)
 
-	// kv-v2 is an alias for kv, version 2. Vault will report it back as "kv"
-	// and requires special handling to avoid perpetual drift.
-	if cfgType == "kv-v2" && mount.Type == "kv" && mount.Options["version"] == "2" {
-		mount.Type = "kv-v2"
+		// kv-v2 is an alias for kv, version 2. Vault will report it back as "kv"
+		// and requires special handling to avoid perpetual drift.
+		if cfgType == "kv-v2" && mount.Type == "kv" && mount.Options["version"] == "2" {
+			mount.Type = "kv-v2"
 
-		// The options block may be omitted when specifying kv-v2, but will always
-		// be present in Vault's response if version 2. Omit the version setting
-		// if it wasn't explicitly set in config.
-		if opts(d)["version"] == "" {
-			delete(mount.Options, "version")
+			// The options block may be omitted when specifying kv-v2, but will always
+			// be present in Vault's response if version 2. Omit the version setting
+			// if it wasn't explicitly set in config.
+			if opts(d)["version"] == "" {
+				delete(mount.Options, "version")
+			}
 		}
+
+		d.Set("type", mount.Type)
 	}
 
 	d.Set("path", path)
-	d.Set("type", mount.Type)
 	d.Set("description", mount.Description)
 	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
 	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
 	d.Set("accessor", mount.Accessor)
 	d.Set("local", mount.Local)
+	if v, ok := mount.Options["version"]; ok {
+		mount.Options["version"] = normalizeMountVersion(v)
+	}
 	d.Set("options", mount.Options)
 	d.Set("seal_wrap", mount.SealWrap)
 	d.Set("external_entropy_access", mount.ExternalEntropyAccess)
 
+	// allowed_managed_keys and delegated_auth_accessors are not part of the
+	// api.MountConfigOutput, so they have to be read from the tune endpoint
+	// directly. Older versions of Vault do not return them at all, in which
+	// case the current value is left as is.
+	tunePath := "sys/mounts/" + strings.Trim(path, "/") + "/tune"
+	tune, err := client.Logical().Read(tunePath)
+	if err != nil {
+		return fmt.Errorf("error reading %q from Vault: %s", tunePath, err)
+	}
+	if tune != nil {
+		for _, k := range []string{"allowed_managed_keys", "delegated_auth_accessors"} {
+			if v, ok := tune.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+		if v, ok := tune.Data["plugin_version"]; ok {
+			d.Set("plugin_version", v)
+		}
+	}
+
+	return nil
+}
+
+// mountTuneStringList sets the list valued tune parameter key of the mount at
+// path, e.g. allowed_managed_keys.
+func mountTuneStringList(client *api.Client, path, key string, values []interface{}) error {
+	tunePath := "sys/mounts/" + strings.Trim(path, "/") + "/tune"
+
+	log.Printf("[DEBUG] Updating %s of mount %s in Vault", key, path)
+	data := map[string]interface{}{
+		key: expandStringSlice(values),
+	}
+	if _, err := client.Logical().Write(tunePath, data); err != nil {
+		return fmt.Errorf("error updating %s of mount %q: %s", key, path, err)
+	}
+
+	return nil
+}
+
+// mountTunePluginVersion sets the plugin version of the mount at path, then
+// reloads its plugin so that the new version is run straight away.
+func mountTunePluginVersion(client *api.Client, path, version string) error {
+	tunePath := "sys/mounts/" + strings.Trim(path, "/") + "/tune"
+
+	log.Printf("[DEBUG] Updating plugin_version of mount %s in Vault", path)
+	data := map[string]interface{}{
+		"plugin_version": version,
+	}
+	if _, err := client.Logical().Write(tunePath, data); err != nil {
+		return fmt.Errorf("error updating plugin_version of mount %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reloading plugin of mount %s in Vault", path)
+	data = map[string]interface{}{
+		"mounts": []string{strings.Trim(path, "/")},
+	}
+	if _, err := client.Logical().Write("sys/plugins/reload/backend", data); err != nil {
+		return fmt.Errorf("error reloading plugin of mount %q: %s", path, err)
+	}
+
 	return nil
 }
 
@@ -246,3 +413,50 @@ func opts(d *schema.ResourceData) map[string]string {
 	}
 	return options
 }
+
+// normalizeMountVersion returns the canonical form of the version option,
+// so that e.g. "2", " 2" and "02" are treated as the same version.
+func normalizeMountVersion(v string) string {
+	if i, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+		return strconv.Itoa(i)
+	}
+	return v
+}
+
+// mountOptionsDiffSuppress ignores differences in options that Vault treats
+// as equivalent: version values that only differ in their formatting, and a
+// version of 2 that is implied by a kv-v2 mount type.
+func mountOptionsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	mountType, _ := d.Get("type").(string)
+
+	switch k {
+	case "options.version":
+		return mountVersionEquivalent(old, new, mountType)
+	case "options.%":
+		o, n := d.GetChange("options")
+		oldOpts, newOpts := o.(map[string]interface{}), n.(map[string]interface{})
+		for key := range oldOpts {
+			if _, ok := newOpts[key]; !ok && key != "version" {
+				return false
+			}
+		}
+		for key := range newOpts {
+			if _, ok := oldOpts[key]; !ok && key != "version" {
+				return false
+			}
+		}
+		oldVersion, _ := oldOpts["version"].(string)
+		newVersion, _ := newOpts["version"].(string)
+		return mountVersionEquivalent(oldVersion, newVersion, mountType)
+	}
+
+	return false
+}
+
+func mountVersionEquivalent(old, new, mountType string) bool {
+	old, new = normalizeMountVersion(old), normalizeMountVersion(new)
+	if old == new {
+		return true
+	}
+	return mountType == "kv-v2" && new == "" && old == "2"
+}
@@ -1,7 +1,11 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -140,6 +144,61 @@ func TestResourceMount_KVV2(t *testing.T) {
 	})
 }
 
+func TestResourceMount_KVV2Import(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	cfg := fmt.Sprintf(`
+			resource "vault_mount" "test" {
+				path = "%s"
+				type = "kv"
+				options = {
+					version = 2
+				}
+			}`, path)
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: cfg,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "type", "kv"),
+					resource.TestCheckResourceAttr("vault_mount.test", "options.version", "2"),
+				),
+			},
+			{
+				PlanOnly: true,
+				Config:   cfg,
+			},
+			{
+				ResourceName:      "vault_mount.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMountVersionEquivalent(t *testing.T) {
+	tests := []struct {
+		old, new, mountType string
+		expected            bool
+	}{
+		{"2", "2", "kv", true},
+		{"2", " 02", "kv", true},
+		{"1", "2", "kv", false},
+		{"2", "", "kv", false},
+		{"2", "", "kv-v2", true},
+		{"1", "", "kv-v2", false},
+		{"", "", "generic", true},
+	}
+
+	for _, tt := range tests {
+		if actual := mountVersionEquivalent(tt.old, tt.new, tt.mountType); actual != tt.expected {
+			t.Errorf("mountVersionEquivalent(%q, %q, %q) = %t, expected %t", tt.old, tt.new, tt.mountType, actual, tt.expected)
+		}
+	}
+}
+
 func TestResourceMount_ExternalEntropyAccess(t *testing.T) {
 	path := acctest.RandomWithPrefix("example")
 	resource.Test(t, resource.TestCase{
@@ -540,6 +599,224 @@ resource "vault_mount" "test" {
 `, path, externalEntropyAccess)
 }
 
+func TestResourceMount_AllowedManagedKeys(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	path := acctest.RandomWithPrefix("example")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_AllowedManagedKeysConfig(path, `["kms-key-1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "allowed_managed_keys.#", "1"),
+				),
+			},
+			{
+				Config: testResourceMount_AllowedManagedKeysConfig(path, `["kms-key-1", "kms-key-2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "allowed_managed_keys.#", "2"),
+				),
+			},
+			{
+				// Removing the keys from the configuration keeps them.
+				Config: testResourceMount_AllowedManagedKeysConfig(path, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "allowed_managed_keys.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceMount_AllowedManagedKeysConfig(path, keys string) string {
+	if keys == "" {
+		return fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path = "%s"
+	type = "pki"
+}
+`, path)
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path                 = "%s"
+	type                 = "pki"
+	allowed_managed_keys = %s
+}
+`, path, keys)
+}
+
+func TestResourceMount_DelegatedAuthAccessors(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheckVaultVersion(t, "1.15.0") },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_DelegatedAuthAccessorsConfig(path, `[vault_auth_backend.a.accessor]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "delegated_auth_accessors.#", "1"),
+				),
+			},
+			{
+				Config: testResourceMount_DelegatedAuthAccessorsConfig(path, `[vault_auth_backend.a.accessor, vault_auth_backend.b.accessor]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "delegated_auth_accessors.#", "2"),
+				),
+			},
+			{
+				// Removing the accessors from the configuration keeps them.
+				Config: testResourceMount_DelegatedAuthAccessorsConfig(path, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "delegated_auth_accessors.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceMount_DelegatedAuthAccessorsConfig(path, accessors string) string {
+	var delegatedAuthAccessors string
+	if accessors != "" {
+		delegatedAuthAccessors = "\n\tdelegated_auth_accessors = " + accessors
+	}
+
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "a" {
+	type = "userpass"
+	path = "%[1]s-a"
+}
+
+resource "vault_auth_backend" "b" {
+	type = "userpass"
+	path = "%[1]s-b"
+}
+
+resource "vault_mount" "test" {
+	path = "%[1]s"
+	type = "kv"%[2]s
+}
+`, path, delegatedAuthAccessors)
+}
+
+func TestResourceMount_PluginVersion(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	var accessor string
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheckVaultVersion(t, "1.12.0") },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_PluginVersionConfig(path, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "plugin_version", ""),
+					testResourceMount_StoreAccessor("vault_mount.test", &accessor),
+				),
+			},
+			{
+				// Select the builtin version of the plugin explicitly, the
+				// mount is tuned in place rather than recreated.
+				Config: testResourceMount_PluginVersionConfig(path, `data.vault_generic_secret.kv.data["version"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vault_mount.test", "plugin_version", "data.vault_generic_secret.kv", "data.version"),
+					resource.TestCheckResourceAttrPtr("vault_mount.test", "accessor", &accessor),
+				),
+			},
+		},
+	})
+}
+
+func testResourceMount_PluginVersionConfig(path, version string) string {
+	var pluginVersion string
+	if version != "" {
+		pluginVersion = "\n\tplugin_version = " + version
+	}
+
+	return fmt.Sprintf(`
+data "vault_generic_secret" "kv" {
+	path = "sys/plugins/catalog/secret/kv"
+}
+
+resource "vault_mount" "test" {
+	path = "%s"
+	type = "kv"%s
+}
+`, path, pluginVersion)
+}
+
+func testResourceMount_StoreAccessor(n string, accessor *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", n)
+		}
+
+		*accessor = rs.Primary.Attributes["accessor"]
+		if *accessor == "" {
+			return fmt.Errorf("resource %q has no accessor", n)
+		}
+		return nil
+	}
+}
+
+func TestMountPluginVersion(t *testing.T) {
+	var requests []string
+	bodies := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+		requests = append(requests, request)
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected request body: %s", err)
+		}
+		bodies[request] = body
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := MountResource().TestResourceData()
+	d.Set("path", "foo")
+	d.Set("type", "kv")
+	d.Set("plugin_version", "v1.0.0")
+	if err := createMount(d, client, "foo", "kv"); err != nil {
+		t.Fatal(err)
+	}
+	if v := bodies["POST /v1/sys/mounts/foo"]["plugin_version"]; v != "v1.0.0" {
+		t.Fatalf("expected the mount to be created with plugin_version %q, got %v", "v1.0.0", v)
+	}
+
+	requests = nil
+	if err := mountTunePluginVersion(client, "foo/", "v1.1.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"PUT /v1/sys/mounts/foo/tune",
+		"PUT /v1/sys/plugins/reload/backend",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if v := bodies["PUT /v1/sys/mounts/foo/tune"]["plugin_version"]; v != "v1.1.0" {
+		t.Fatalf("expected plugin_version %q to be tuned, got %v", "v1.1.0", v)
+	}
+	if v := bodies["PUT /v1/sys/plugins/reload/backend"]["mounts"]; fmt.Sprint(v) != "[foo]" {
+		t.Fatalf("expected the plugin of mount %q to be reloaded, got %v", "foo", v)
+	}
+}
+
 func findMount(path string) (*api.MountOutput, error) {
 	client := testProvider.Meta().(*api.Client)
 
@@ -1,12 +1,15 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
 )
 
 func namespaceResource() *schema.Resource {
@@ -32,6 +35,21 @@ func namespaceResource() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the namepsace.",
 			},
+
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata describing this namespace. Requires Vault 1.12+.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"path_fq": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fully qualified path of the namespace, including the parent namespace of the provider.",
+			},
 		},
 	}
 }
@@ -41,16 +59,73 @@ func namespaceWrite(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Get("path").(string)
 
+	var data map[string]interface{}
+	if v, ok := d.GetOk("custom_metadata"); ok {
+		data = map[string]interface{}{
+			"custom_metadata": v,
+		}
+	}
+
 	log.Printf("[DEBUG] Creating namespace %s in Vault", path)
-	_, err := client.Logical().Write("sys/namespaces/"+path, nil)
+	_, err := client.Logical().Write("sys/namespaces/"+path, data)
 
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
+	// Writing to an existing namespace does not update its metadata.
+	if !d.IsNewResource() && d.HasChange("custom_metadata") {
+		log.Printf("[DEBUG] Updating custom_metadata of namespace %s in Vault", path)
+		if err := namespacePatchCustomMetadata(client, path, d.Get("custom_metadata").(map[string]interface{})); err != nil {
+			return fmt.Errorf("error updating custom_metadata of namespace %s: %s", path, err)
+		}
+	}
+
 	return namespaceRead(d, meta)
 }
 
+func namespacePatchCustomMetadata(client *api.Client, path string, customMetadata map[string]interface{}) error {
+	// Keys removed from the configuration must be explicitly nulled out
+	// in the merge patch, otherwise Vault keeps them.
+	body := map[string]interface{}{}
+	for k, v := range customMetadata {
+		body[k] = v
+	}
+
+	resp, err := client.Logical().Read("sys/namespaces/" + path)
+	if err != nil {
+		return err
+	}
+	if resp != nil {
+		if current, ok := resp.Data["custom_metadata"].(map[string]interface{}); ok {
+			for k := range current {
+				if _, ok := body[k]; !ok {
+					body[k] = nil
+				}
+			}
+		}
+	}
+
+	r := client.NewRequest("PATCH", "/v1/sys/namespaces/"+path)
+	if r.Headers == nil {
+		r.Headers = make(http.Header)
+	}
+	r.Headers.Set("Content-Type", "application/merge-patch+json")
+	if err := r.SetJSONBody(map[string]interface{}{"custom_metadata": body}); err != nil {
+		return err
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	rawResp, err := client.RawRequestWithContext(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer rawResp.Body.Close()
+
+	return nil
+}
+
 func namespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -92,6 +167,18 @@ func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 	noTrailingSlashPath := strings.TrimSuffix(path, "/")
 	d.Set("path", noTrailingSlashPath)
 
+	pathFQ := noTrailingSlashPath
+	if parent := strings.Trim(client.Headers().Get(consts.NamespaceHeaderName), "/"); parent != "" {
+		pathFQ = parent + "/" + pathFQ
+	}
+	d.Set("path_fq", pathFQ)
+
+	if v, ok := resp.Data["custom_metadata"]; ok {
+		if err := d.Set("custom_metadata", v); err != nil {
+			return fmt.Errorf("error setting custom_metadata for namespace %s: %s", path, err)
+		}
+	}
+
 	return nil
 }
 
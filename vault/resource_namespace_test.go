@@ -45,6 +45,45 @@ func TestNamespace_basic(t *testing.T) {
 	})
 }
 
+func TestNamespace_customMetadata(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	namespacePath := acctest.RandomWithPrefix("test-namespace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testNamespaceDestroy(namespacePath),
+		Steps: []resource.TestStep{
+			{
+				Config: testNamespaceConfigCustomMetadata(namespacePath, `
+    foo = "abc"
+    zip = "zap"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_namespace.test", "path", namespacePath),
+					resource.TestCheckResourceAttr("vault_namespace.test", "path_fq", namespacePath),
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.%", "2"),
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.foo", "abc"),
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.zip", "zap"),
+				),
+			},
+			{
+				Config: testNamespaceConfigCustomMetadata(namespacePath, `
+    foo = "bcd"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.foo", "bcd"),
+				),
+			},
+		},
+	})
+}
+
 func testNamespaceCheckAttrs() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState := s.Modules[0].Resources["vault_namespace.test"]
@@ -86,6 +125,17 @@ resource "vault_namespace" "test" {
 
 }
 
+func testNamespaceConfigCustomMetadata(path, metadata string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path            = %q
+  custom_metadata = {
+%s
+  }
+}
+`, path, metadata)
+}
+
 func testNestedNamespaceConfig(parentPath, childPath string) string {
 	return fmt.Sprintf(`
 provider "vault" {
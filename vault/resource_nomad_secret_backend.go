@@ -298,15 +298,17 @@ func updateNomadAccessConfigResource(d *schema.ResourceData, meta interface{}) e
 		data["address"] = raw
 	}
 
-	if raw, ok := d.GetOk("ca_cert"); ok {
+	// The TLS fields are sent whenever they change, so that removing them
+	// from the configuration disables TLS.
+	if raw, ok := d.GetOk("ca_cert"); ok || d.HasChange("ca_cert") {
 		data["ca_cert"] = raw
 	}
 
-	if raw, ok := d.GetOk("client_cert"); ok {
+	if raw, ok := d.GetOk("client_cert"); ok || d.HasChange("client_cert") {
 		data["client_cert"] = raw
 	}
 
-	if raw, ok := d.GetOk("client_key"); ok {
+	if raw, ok := d.GetOk("client_key"); ok || d.HasChange("client_key") {
 		data["client_key"] = raw
 	}
 
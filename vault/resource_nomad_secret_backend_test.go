@@ -62,6 +62,29 @@ func TestAccNomadSecretBackend(t *testing.T) {
 	})
 }
 
+func TestAccNomadSecretBackend_tls(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-nomad")
+	address, token := util.GetTestNomadCreds(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:                 testProviders,
+		PreCheck:                  func() { util.TestAccPreCheck(t) },
+		PreventPostDestroyRefresh: true,
+		CheckDestroy:              testAccNomadSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testNomadSecretBackendConfig_tls(backend, address, token, `
+	ca_cert = <<EOT`+testCertificate+`EOT`),
+				Check: resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "ca_cert", strings.TrimPrefix(testCertificate, "\n")),
+			},
+			{
+				Config: testNomadSecretBackendConfig_tls(backend, address, token, ""),
+				Check:  resource.TestCheckResourceAttr("vault_nomad_secret_backend.test", "ca_cert", ""),
+			},
+		},
+	})
+}
+
 func testAccNomadSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -99,3 +122,14 @@ resource "vault_nomad_secret_backend" "test" {
 }
 `, backend, address, token, maxTTL, ttl, defaultLease, maxLease)
 }
+
+func testNomadSecretBackendConfig_tls(backend, address, token, tls string) string {
+	return fmt.Sprintf(`
+resource "vault_nomad_secret_backend" "test" {
+	backend = "%s"
+	address = "%s"
+	token   = "%s"
+%s
+}
+`, backend, address, token, tls)
+}
@@ -351,6 +351,9 @@ func oktaReadAllGroups(client *api.Client, path string) (*schema.Set, error) {
 		if err != nil {
 			return nil, fmt.Errorf("unable to read group %s from %s in Vault: %s", path, groupName, err)
 		}
+		if group == nil {
+			continue
+		}
 
 		policies := &schema.Set{F: schema.HashString}
 		for _, v := range group.Policies {
@@ -379,6 +382,9 @@ func oktaReadAllUsers(client *api.Client, path string) (*schema.Set, error) {
 		if err != nil {
 			return nil, fmt.Errorf("unable to read user %s from %s in Vault: %s", path, userName, err)
 		}
+		if user == nil {
+			continue
+		}
 
 		groups := &schema.Set{F: schema.HashString}
 		for _, v := range user.Groups {
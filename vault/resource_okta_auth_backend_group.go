@@ -110,23 +110,18 @@ func oktaAuthBackendGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Reading group %s from Okta auth backend %s", groupName, backend)
 
-	present, err := isOktaGroupPresent(client, backend, groupName)
-
+	group, err := readOktaGroup(client, backend, groupName)
 	if err != nil {
 		return fmt.Errorf("unable to read group %s from Vault: %s", groupName, err)
 	}
 
-	if !present {
+	if group == nil {
 		// Group not found, so remove this resource
+		log.Printf("[WARN] Group %s not found in Okta auth backend %s, removing from state", groupName, backend)
 		d.SetId("")
 		return nil
 	}
 
-	group, err := readOktaGroup(client, backend, groupName)
-	if err != nil {
-		return fmt.Errorf("unable to update group %s from Vault: %s", groupName, err)
-	}
-
 	d.Set("policies", group.Policies)
 	d.Set("group_name", group.Name)
 	d.Set("path", backend)
@@ -63,6 +63,39 @@ func TestAccOktaAuthBackendGroup_specialChar(t *testing.T) {
 	})
 }
 
+func TestAccOktaAuthBackendGroup_removedExternally(t *testing.T) {
+	path := "okta-" + strconv.Itoa(acctest.RandInt())
+	organization := "dummy"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccOktaAuthBackendGroup_Destroyed(path, "foo"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOktaAuthGroupConfig_basic(path, organization),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOktaAuthBackendGroup_InitialCheck,
+					testAccOktaAuthBackend_GroupsCheck(path, "foo", []string{"one", "two", "default"}),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if err := deleteOktaGroup(client, path, "foo"); err != nil {
+						t.Fatalf("error deleting Okta group: %s", err)
+					}
+				},
+				Config: testAccOktaAuthGroupConfig_basic(path, organization),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOktaAuthBackendGroup_InitialCheck,
+					testAccOktaAuthBackend_GroupsCheck(path, "foo", []string{"one", "two", "default"}),
+				),
+			},
+		},
+	})
+}
+
 func testAccOktaAuthGroupConfig_basic(path string, organization string) string {
 	return fmt.Sprintf(`
 resource "vault_okta_auth_backend" "test" {
@@ -118,23 +118,18 @@ func oktaAuthBackendUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Reading user %s from Okta auth backend %s", username, path)
 
-	present, err := isOktaUserPresent(client, path, username)
-
+	user, err := readOktaUser(client, path, username)
 	if err != nil {
-		return fmt.Errorf("unable to read user %s in Vault: %s", username, err)
+		return fmt.Errorf("unable to read user %s from Vault: %s", username, err)
 	}
 
-	if !present {
+	if user == nil {
 		// User not found, so remove this resource
+		log.Printf("[WARN] User %s not found in Okta auth backend %s, removing from state", username, path)
 		d.SetId("")
 		return nil
 	}
 
-	user, err := readOktaUser(client, path, username)
-	if err != nil {
-		return fmt.Errorf("unable to update user %s from Vault: %s", username, err)
-	}
-
 	d.Set("groups", user.Groups)
 	d.Set("policies", user.Policies)
 
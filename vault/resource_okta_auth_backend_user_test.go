@@ -31,6 +31,39 @@ func TestAccOktaAuthBackendUser(t *testing.T) {
 	})
 }
 
+func TestAccOktaAuthBackendUser_removedExternally(t *testing.T) {
+	path := "okta-" + strconv.Itoa(acctest.RandInt())
+	organization := "dummy"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccOktaAuthBackendUser_Destroyed(path, "user_test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOktaAuthUserConfig(path, organization),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOktaAuthBackendUser_InitialCheck,
+					testAccOktaAuthBackend_UsersCheck(path, "user_test", []string{"one", "two"}, []string{"three"}),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if err := deleteOktaUser(client, path, "user_test"); err != nil {
+						t.Fatalf("error deleting Okta user: %s", err)
+					}
+				},
+				Config: testAccOktaAuthUserConfig(path, organization),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOktaAuthBackendUser_InitialCheck,
+					testAccOktaAuthBackend_UsersCheck(path, "user_test", []string{"one", "two"}, []string{"three"}),
+				),
+			},
+		},
+	})
+}
+
 func testAccOktaAuthUserConfig(path string, organization string) string {
 	return fmt.Sprintf(`
 resource "vault_okta_auth_backend" "test" {
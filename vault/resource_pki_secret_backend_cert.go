@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"strings"
@@ -150,6 +152,11 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Computed:    true,
 				Description: "The certificate expiration.",
 			},
+			"renew_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Initially false, and then set to true during refresh once the expiration is less than min_seconds_remaining in the future.",
+			},
 		},
 	}
 }
@@ -245,6 +252,22 @@ func pkiSecretBackendCertNeedsRenewed(autoRenew bool, expiration int, minSecRema
 	return time.Now().After(renewTime)
 }
 
+// pkiSecretBackendCertExpiration returns the expiration of the PEM encoded
+// certificate as a unix timestamp.
+func pkiSecretBackendCertExpiration(certificate string) (int, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return 0, fmt.Errorf("no PEM data found in certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing certificate: %s", err)
+	}
+
+	return int(cert.NotAfter.Unix()), nil
+}
+
 func pkiSecretBackendCertDiff(d *schema.ResourceDiff, meta interface{}) error {
 	if d.Id() == "" {
 		return nil
@@ -254,7 +277,7 @@ func pkiSecretBackendCertDiff(d *schema.ResourceDiff, meta interface{}) error {
 	if v, ok := d.GetOk("min_seconds_remaining"); ok {
 		minSeconds = v.(int)
 	}
-	if pkiSecretBackendCertNeedsRenewed(d.Get("auto_renew").(bool), d.Get("expiration").(int), minSeconds) {
+	if d.Get("renew_pending").(bool) || pkiSecretBackendCertNeedsRenewed(d.Get("auto_renew").(bool), d.Get("expiration").(int), minSeconds) {
 		log.Printf("[DEBUG] certificate %q is due for renewal", d.Id())
 		if err := d.SetNewComputed("certificate"); err != nil {
 			return err
@@ -270,6 +293,22 @@ func pkiSecretBackendCertDiff(d *schema.ResourceDiff, meta interface{}) error {
 }
 
 func pkiSecretBackendCertRead(d *schema.ResourceData, meta interface{}) error {
+	expiration := d.Get("expiration").(int)
+	if v, ok := d.GetOk("certificate"); ok {
+		certExpiration, err := pkiSecretBackendCertExpiration(v.(string))
+		if err != nil {
+			log.Printf("[WARN] unable to read the expiration of certificate %q, using %d: %s", d.Id(), expiration, err)
+		} else {
+			expiration = certExpiration
+		}
+	}
+
+	minSeconds := 0
+	if v, ok := d.GetOk("min_seconds_remaining"); ok {
+		minSeconds = v.(int)
+	}
+	d.Set("renew_pending", pkiSecretBackendCertNeedsRenewed(d.Get("auto_renew").(bool), expiration, minSeconds))
+
 	return nil
 }
 
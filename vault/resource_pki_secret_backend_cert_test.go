@@ -1,7 +1,13 @@
 package vault
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 	"testing"
@@ -157,6 +163,7 @@ func TestPkiSecretBackendCert_renew(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "ttl", "1h"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "min_seconds_remaining", "3595"),
 					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "expiration"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "renew_pending", "false"),
 				),
 			},
 			{
@@ -167,6 +174,7 @@ func TestPkiSecretBackendCert_renew(t *testing.T) {
 				Config: testPkiSecretBackendCertConfig_renew(rootPath),
 				Check: resource.ComposeTestCheckFunc(
 					testPkiSecretBackendCertWaitUntilRenewal("vault_pki_secret_backend_cert.test"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "renew_pending", "false"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "backend", rootPath),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "common_name", "cert.test.my.domain"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "ttl", "1h"),
@@ -263,3 +271,42 @@ func testPkiSecretBackendCertWaitUntilRenewal(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestPkiSecretBackendCertExpiration(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "cert.test.my.domain"},
+		NotBefore:    time.Now(),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	expiration, err := pkiSecretBackendCertExpiration(certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expiration != int(notAfter.Unix()) {
+		t.Fatalf("expected expiration %d, got %d", notAfter.Unix(), expiration)
+	}
+
+	if pkiSecretBackendCertNeedsRenewed(true, expiration, 60) {
+		t.Fatal("certificate should not need to be renewed")
+	}
+	if !pkiSecretBackendCertNeedsRenewed(true, expiration, 7200) {
+		t.Fatal("certificate should need to be renewed")
+	}
+
+	if _, err := pkiSecretBackendCertExpiration("not a certificate"); err == nil {
+		t.Fatal("expected an error for invalid PEM data")
+	}
+}
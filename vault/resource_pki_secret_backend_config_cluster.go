@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigClusterResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigClusterCreate,
+		Read:   pkiSecretBackendConfigClusterRead,
+		Update: pkiSecretBackendConfigClusterUpdate,
+		Delete: pkiSecretBackendConfigClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the path to this performance replication cluster's API mount path.",
+			},
+			"aia_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the path to this performance replication cluster's AIA distribution point.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	backend := d.Get("backend").(string)
+
+	d.SetId(pkiSecretBackendConfigClusterPath(backend))
+
+	return pkiSecretBackendConfigClusterUpdate(d, meta)
+}
+
+func pkiSecretBackendConfigClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"path":     d.Get("path"),
+		"aia_path": d.Get("aia_path"),
+	}
+
+	log.Printf("[DEBUG] Updating cluster config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating cluster config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated cluster config %q", path)
+
+	return pkiSecretBackendConfigClusterRead(d, meta)
+}
+
+func pkiSecretBackendConfigClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading cluster config %q", path)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading cluster config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read cluster config %q", path)
+	if config == nil {
+		log.Printf("[WARN] Cluster config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", strings.TrimSuffix(path, "/config/cluster"))
+	d.Set("path", config.Data["path"])
+	d.Set("aia_path", config.Data["aia_path"])
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	// Vault cannot unset the cluster config, it is removed with the mount.
+	return nil
+}
+
+func pkiSecretBackendConfigClusterPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/cluster"
+}
@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPkiSecretBackendConfigCluster_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_config_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendConfigClusterMountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigClusterConfig(backend, "http://127.0.0.1:8200/v1/"+backend, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "path", "http://127.0.0.1:8200/v1/"+backend),
+					resource.TestCheckResourceAttr(resourceName, "aia_path", ""),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigClusterConfig(backend, "http://127.0.0.1:8200/v1/"+backend, "http://example.com/v1/"+backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", "http://127.0.0.1:8200/v1/"+backend),
+					resource.TestCheckResourceAttr(resourceName, "aia_path", "http://example.com/v1/"+backend),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testPkiSecretBackendConfigClusterMountDestroy checks that the cluster config
+// is gone together with its mount. Destroying the resource on its own leaves
+// the config in place, as Vault cannot unset it.
+func testPkiSecretBackendConfigClusterMountDestroy(s *terraform.State) error {
+	paths, err := listPkiPaths(s)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		return fmt.Errorf("mount %q still exists", path)
+	}
+
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_pki_secret_backend_config_cluster" {
+			continue
+		}
+		config, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for cluster config %q: %s", rs.Primary.ID, err)
+		}
+		if config != nil {
+			return fmt.Errorf("cluster config %q still exists after its mount was removed", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testPkiSecretBackendConfigClusterConfig(backend, path, aiaPath string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend  = "${vault_pki_secret_backend.test.path}"
+  path     = "%s"
+  aia_path = "%s"
+}
+`, backend, path, aiaPath)
+}
@@ -16,6 +16,8 @@ func pkiSecretBackendConfigUrlsResource() *schema.Resource {
 		Update: pkiSecretBackendConfigUrlsUpdate,
 		Delete: pkiSecretBackendConfigUrlsDelete,
 
+		CustomizeDiff: pkiSecretBackendConfigUrlsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"backend": {
 				Type:        schema.TypeString,
@@ -40,6 +42,11 @@ func pkiSecretBackendConfigUrlsResource() *schema.Resource {
 				Description: "Specifies the URL values for the OCSP Servers field.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"enable_templating": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies whether the URLs may contain templated values like {{issuer_id}} or {{cluster_path}}. Requires Vault 1.13+.",
+			},
 		},
 	}
 }
@@ -60,6 +67,17 @@ func pkiSecretBackendConfigUrlsCreate(d *schema.ResourceData, meta interface{})
 		"crl_distribution_points": crlDistributionsPoints,
 		"ocsp_servers":            ocspServers,
 	}
+	if d.Get("enable_templating").(bool) {
+		data["enable_templating"] = true
+	} else if d.HasChange("enable_templating") {
+		data["enable_templating"] = false
+	}
+
+	if d.Get("enable_templating").(bool) {
+		if err := pkiSecretBackendConfigUrlsCheckTemplating(client, path); err != nil {
+			return err
+		}
+	}
 
 	log.Printf("[DEBUG] Creating URL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -69,6 +87,7 @@ func pkiSecretBackendConfigUrlsCreate(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Created URL config on PKI secret backend %q", backend)
 
 	d.SetId(fmt.Sprintf("%s/config/urls", backend))
+
 	return pkiSecretBackendConfigUrlsRead(d, meta)
 }
 
@@ -94,6 +113,9 @@ func pkiSecretBackendConfigUrlsRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("issuing_certificates", config.Data["issuing_certificates"])
 	d.Set("crl_distribution_points", config.Data["crl_distribution_points"])
 	d.Set("ocsp_servers", config.Data["ocsp_servers"])
+	if v, ok := config.Data["enable_templating"]; ok {
+		d.Set("enable_templating", v)
+	}
 
 	return nil
 }
@@ -114,6 +136,17 @@ func pkiSecretBackendConfigUrlsUpdate(d *schema.ResourceData, meta interface{})
 		"crl_distribution_points": crlDistributionsPoints,
 		"ocsp_servers":            ocspServers,
 	}
+	if d.Get("enable_templating").(bool) {
+		data["enable_templating"] = true
+	} else if d.HasChange("enable_templating") {
+		data["enable_templating"] = false
+	}
+
+	if d.Get("enable_templating").(bool) {
+		if err := pkiSecretBackendConfigUrlsCheckTemplating(client, path); err != nil {
+			return err
+		}
+	}
 
 	log.Printf("[DEBUG] Updating URL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -133,3 +166,40 @@ func pkiSecretBackendConfigUrlsDelete(d *schema.ResourceData, meta interface{})
 func pkiSecretBackendConfigUrlsPath(backend string) string {
 	return strings.Trim(backend, "/") + "/config/urls"
 }
+
+func pkiSecretBackendConfigUrlsDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("enable_templating") || d.Get("enable_templating").(bool) {
+		return nil
+	}
+
+	for _, k := range []string{"issuing_certificates", "crl_distribution_points", "ocsp_servers"} {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+		for _, url := range d.Get(k).([]interface{}) {
+			if s, ok := url.(string); ok && strings.Contains(s, "{{") {
+				return fmt.Errorf("%s: templated URL %q requires enable_templating to be set", k, s)
+			}
+		}
+	}
+	return nil
+}
+
+// pkiSecretBackendConfigUrlsCheckTemplating ensures that the Vault server
+// actually supports templated URLs before they are written, as versions prior
+// to 1.13 silently ignore enable_templating and would then encode the
+// templates verbatim. Those versions don't return enable_templating when the
+// URL config at path is read.
+func pkiSecretBackendConfigUrlsCheckTemplating(client *api.Client, path string) error {
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading URL config on PKI secret backend %q: %s", path, err)
+	}
+	if config == nil {
+		return nil
+	}
+	if _, ok := config.Data["enable_templating"]; !ok {
+		return fmt.Errorf("enable_templating is not supported by this version of Vault, Vault 1.13+ is required")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -42,6 +43,35 @@ func TestPkiSecretBackendConfigUrls_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendConfigUrls_templating(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+
+	issuingCertificates := "{{cluster_aia_path}}/issuer/{{issuer_id}}/der"
+	crlDistributionPoints := "{{cluster_aia_path}}/issuer/{{issuer_id}}/crl/der"
+	ocspServers := "{{cluster_path}}/ocsp"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendConfigUrlsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendCertConfigUrlsConfig_basic(rootPath, issuingCertificates, crlDistributionPoints, ocspServers),
+				ExpectError: regexp.MustCompile("requires enable_templating to be set"),
+			},
+			{
+				Config: testPkiSecretBackendCertConfigUrlsConfig_templating(rootPath, issuingCertificates, crlDistributionPoints, ocspServers),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "enable_templating", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "issuing_certificates.0", issuingCertificates),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "crl_distribution_points.0", crlDistributionPoints),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "ocsp_servers.0", ocspServers),
+				),
+			},
+		},
+	})
+}
+
 func testPkiSecretBackendConfigUrlsEmptyRead(s *terraform.State) error {
 	paths, err := listPkiPaths(s)
 	if err != nil {
@@ -127,3 +157,31 @@ resource "vault_pki_secret_backend_config_urls" "test" {
 
 `, rootPath, issuingCertificates, crlDistributionPoints, ocspServers)
 }
+
+func testPkiSecretBackendCertConfigUrlsConfig_templating(rootPath string, issuingCertificates string, crlDistributionPoints string, ocspServers string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test-root" {
+  path = "%s"
+  description = "test root"
+  default_lease_ttl_seconds = "8640000"
+  max_lease_ttl_seconds = "8640000"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend  = "${vault_pki_secret_backend.test-root.path}"
+  path     = "http://127.0.0.1:8200/v1/${vault_pki_secret_backend.test-root.path}"
+  aia_path = "http://127.0.0.1:8200/v1/${vault_pki_secret_backend.test-root.path}"
+}
+
+resource "vault_pki_secret_backend_config_urls" "test" {
+  depends_on = [ "vault_pki_secret_backend_config_cluster.test" ]
+
+  backend = "${vault_pki_secret_backend.test-root.path}"
+
+  enable_templating = true
+  issuing_certificates = ["%s"]
+  crl_distribution_points = ["%s"]
+  ocsp_servers = ["%s"]
+}
+`, rootPath, issuingCertificates, crlDistributionPoints, ocspServers)
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+// pkiSecretBackendCrlConfigFields are the fields introduced along with the
+// OCSP responder and automatic CRL rebuilding in Vault 1.12; they are only sent
+// when configured so that older versions keep working.
+var pkiSecretBackendCrlConfigFields = []string{
+	"ocsp_disable",
+	"ocsp_expiry",
+	"auto_rebuild",
+	"auto_rebuild_grace_period",
+	"enable_delta",
+	"delta_rebuild_interval",
+}
+
 func pkiSecretBackendCrlConfigResource() *schema.Resource {
 	return &schema.Resource{
 		Create: pkiSecretBackendCrlConfigCreate,
@@ -37,6 +49,42 @@ func pkiSecretBackendCrlConfigResource() *schema.Resource {
 				Optional:    true,
 				Description: "Disables or enables CRL building",
 			},
+			"ocsp_disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Disables or enables the OCSP responder.",
+			},
+			"ocsp_expiry": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The amount of time an OCSP response will be valid.",
+			},
+			"auto_rebuild": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enables or disables periodic rebuilding of the CRL upon expiry.",
+			},
+			"auto_rebuild_grace_period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Grace period before CRL expiry to attempt rebuild of CRL.",
+			},
+			"enable_delta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enables or disables building of delta CRLs with up-to-date revocation information.",
+			},
+			"delta_rebuild_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Interval to check for new revocations on, to regenerate the delta CRL.",
+			},
 		},
 	}
 }
@@ -54,6 +102,11 @@ func pkiSecretBackendCrlConfigCreate(d *schema.ResourceData, meta interface{}) e
 	if disable, ok := d.GetOk("disable"); ok {
 		data["disable"] = disable
 	}
+	for _, k := range pkiSecretBackendCrlConfigFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
 
 	log.Printf("[DEBUG] Creating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -81,9 +134,23 @@ func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("invalid path ID %q: %s", path, err)
 	}
 
+	if config == nil {
+		log.Printf("[WARN] CRL config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("expiry", config.Data["expiry"])
 	d.Set("disable", config.Data["disable"])
 
+	for _, k := range pkiSecretBackendCrlConfigFields {
+		if v, ok := config.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on CRL config %q: %s", k, path, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -100,6 +167,11 @@ func pkiSecretBackendCrlConfigUpdate(d *schema.ResourceData, meta interface{}) e
 	if disable, ok := d.GetOk("disable"); ok {
 		data["disable"] = disable
 	}
+	for _, k := range pkiSecretBackendCrlConfigFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
 
 	log.Printf("[DEBUG] Updating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -27,6 +27,19 @@ func TestPkiSecretBackendCrlConfig_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "disable", "true"),
 				),
 			},
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_ocsp(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "expiry", "72h"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "disable", "false"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "ocsp_disable", "false"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "ocsp_expiry", "24h"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "auto_rebuild", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "auto_rebuild_grace_period", "24h"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "enable_delta", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_config.test", "delta_rebuild_interval", "30m"),
+				),
+			},
 		},
 	})
 }
@@ -90,3 +103,45 @@ resource "vault_pki_secret_backend_crl_config" "test" {
 
 `, rootPath)
 }
+
+func testPkiSecretBackendCrlConfigConfig_ocsp(rootPath string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path = "%s"
+  type = "pki"
+  description = "test root"
+  default_lease_ttl_seconds = "8640000"
+  max_lease_ttl_seconds = "8640000"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test-ca" {
+	backend    = vault_mount.test-root.path
+	depends_on = ["vault_mount.test-root"]
+
+	type                 = "internal"
+	common_name          = "test-ca.example.com"
+	ttl                  = "8640000"
+	format               = "pem"
+	private_key_format   = "der"
+	key_type             = "rsa"
+	key_bits             = 4096
+	ou                   = "Test OU"
+	organization         = "ACME Ltd"
+}
+
+resource "vault_pki_secret_backend_crl_config" "test" {
+  depends_on = ["vault_mount.test-root","vault_pki_secret_backend_root_cert.test-ca"]
+
+  backend = "${vault_mount.test-root.path}"
+
+  expiry                    = "72h"
+  disable                   = false
+  ocsp_disable              = false
+  ocsp_expiry               = "24h"
+  auto_rebuild              = true
+  auto_rebuild_grace_period = "24h"
+  enable_delta              = true
+  delta_rebuild_interval    = "30m"
+}
+`, rootPath)
+}
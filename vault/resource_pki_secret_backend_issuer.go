@@ -0,0 +1,208 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendIssuerFields = []string{
+	"issuer_name",
+	"leaf_not_after_behavior",
+	"usage",
+	"manual_chain",
+	"revocation_signature_algorithm",
+	"issuing_certificates",
+	"crl_distribution_points",
+	"ocsp_servers",
+}
+
+func pkiSecretBackendIssuerResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIssuerCreate,
+		Read:   pkiSecretBackendIssuerRead,
+		Update: pkiSecretBackendIssuerUpdate,
+		Delete: pkiSecretBackendIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Reference to an existing issuer, either its ID, its name or \"default\".",
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer.",
+			},
+			"issuer_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the issuer.",
+			},
+			"leaf_not_after_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Behavior of a leaf's NotAfter field going beyond the issuer's: \"err\" to error, \"truncate\" to truncate it, or \"permit\" to allow it.",
+				ValidateFunc: validation.StringInSlice([]string{"err", "truncate", "permit"}, false),
+			},
+			"usage": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Comma-separated list of the allowed usages of the issuer, among \"read-only\", \"issuing-certificates\", \"crl-signing\" and \"ocsp-signing\".",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// Vault returns the usages in its own order.
+					return pkiSecretBackendIssuerSortedUsage(old) == pkiSecretBackendIssuerSortedUsage(new)
+				},
+			},
+			"manual_chain": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Issuer references to use to build the CA chain of the issuer, instead of building it automatically.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"revocation_signature_algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The signature algorithm used by the issuer to sign CRLs and OCSP responses.",
+			},
+			"issuing_certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the Issuing Certificate field.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"crl_distribution_points": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the CRL Distribution Points field.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ocsp_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the OCSP Servers field.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuerCreate(d *schema.ResourceData, meta interface{}) error {
+	path := pkiSecretBackendIssuerPath(d.Get("backend").(string), d.Get("issuer_ref").(string))
+
+	d.SetId(path)
+
+	return pkiSecretBackendIssuerUpdate(d, meta)
+}
+
+func pkiSecretBackendIssuerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendIssuerFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Updating PKI secret backend issuer %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating PKI secret backend issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated PKI secret backend issuer %q", path)
+
+	return pkiSecretBackendIssuerRead(d, meta)
+}
+
+func pkiSecretBackendIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, issuerRef, err := pkiSecretBackendIssuerFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for PKI secret backend issuer: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading PKI secret backend issuer %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading PKI secret backend issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI secret backend issuer %q", path)
+	if resp == nil {
+		log.Printf("[WARN] PKI secret backend issuer %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("issuer_ref", issuerRef)
+
+	for _, k := range append(pkiSecretBackendIssuerFields, "issuer_id") {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on PKI secret backend issuer %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pkiSecretBackendIssuerDelete only removes the issuer from the state, as the
+// resource manages the configuration of an issuer it did not create.
+func pkiSecretBackendIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing PKI secret backend issuer %q from state, the issuer is left in Vault", d.Id())
+	return nil
+}
+
+func pkiSecretBackendIssuerPath(backend, issuerRef string) string {
+	return strings.Trim(backend, "/") + "/issuer/" + strings.Trim(issuerRef, "/")
+}
+
+func pkiSecretBackendIssuerFromPath(path string) (string, string, error) {
+	idx := strings.LastIndex(path, "/issuer/")
+	if idx < 1 {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/issuer/<issuer_ref>")
+	}
+
+	issuerRef := path[idx+len("/issuer/"):]
+	if issuerRef == "" || strings.Contains(issuerRef, "/") {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/issuer/<issuer_ref>")
+	}
+
+	return path[:idx], issuerRef, nil
+}
+
+func pkiSecretBackendIssuerSortedUsage(usage string) string {
+	usages := strings.Split(usage, ",")
+	for i := range usages {
+		usages[i] = strings.TrimSpace(usages[i])
+	}
+	sort.Strings(usages)
+	return strings.Join(usages, ",")
+}
@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPkiSecretBackendIssuer_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_issuer.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRootCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuerConfig(backend, `
+  issuer_name = "root"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "issuer_ref", "default"),
+					resource.TestCheckResourceAttr(resourceName, "issuer_name", "root"),
+					resource.TestCheckResourceAttr(resourceName, "leaf_not_after_behavior", "err"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "usage"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendIssuerConfig(backend, `
+  issuer_name             = "root-2"
+  leaf_not_after_behavior = "truncate"
+  usage                   = "read-only,issuing-certificates,crl-signing"
+  crl_distribution_points = ["http://127.0.0.1:8200/v1/pki/crl"]
+  ocsp_servers            = ["http://127.0.0.1:8200/v1/pki/ocsp"]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer_name", "root-2"),
+					resource.TestCheckResourceAttr(resourceName, "leaf_not_after_behavior", "truncate"),
+					resource.TestCheckResourceAttr(resourceName, "crl_distribution_points.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "crl_distribution_points.0", "http://127.0.0.1:8200/v1/pki/crl"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_servers.0", "http://127.0.0.1:8200/v1/pki/ocsp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying the resource must leave the issuer in Vault.
+				Config: testPkiSecretBackendIssuerConfigBackend(backend),
+				Check:  testPkiSecretBackendIssuerExists(backend, "default", "root-2"),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIssuerExists(backend, issuerRef, issuerName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		path := pkiSecretBackendIssuerPath(backend, issuerRef)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading issuer %q: %s", path, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("expected issuer %q to still exist", path)
+		}
+		if name := resp.Data["issuer_name"]; name != issuerName {
+			return fmt.Errorf("expected issuer %q to be named %q, got %q", path, issuerName, name)
+		}
+		return nil
+	}
+}
+
+func testPkiSecretBackendIssuerConfigBackend(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = "${vault_pki_secret_backend.test.path}"
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+}
+`, backend)
+}
+
+func testPkiSecretBackendIssuerConfig(backend, extra string) string {
+	return testPkiSecretBackendIssuerConfigBackend(backend) + fmt.Sprintf(`
+resource "vault_pki_secret_backend_issuer" "test" {
+  depends_on = ["vault_pki_secret_backend_root_cert.test"]
+  backend    = "${vault_pki_secret_backend.test.path}"
+  issuer_ref = "default"
+%s
+}
+`, extra)
+}
@@ -0,0 +1,244 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendKeyCreate,
+		Read:   pkiSecretBackendKeyRead,
+		Update: pkiSecretBackendKeyUpdate,
+		Delete: pkiSecretBackendKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Type of key to generate. Must be either \"exported\", \"internal\" or \"kms\". Conflicts with 'pem_bundle'.",
+				ValidateFunc:  validation.StringInSlice([]string{"exported", "internal", "kms"}, false),
+				ConflictsWith: []string{"pem_bundle"},
+			},
+			"pem_bundle": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				Description:   "The PEM encoded private key to import. Conflicts with 'type'.",
+				ConflictsWith: []string{"type"},
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the key.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The type of key to generate.",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec", "ed25519"}, false),
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The number of bits of the key to generate.",
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The ID of the managed key to use when type is \"kms\".",
+				ConflictsWith: []string{"managed_key_name"},
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The name of the managed key to use when type is \"kms\".",
+				ConflictsWith: []string{"managed_key_id"},
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key.",
+			},
+			"key_ref": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A stable reference to the key, to use in other PKI endpoints.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated private key, only set when type is \"exported\".",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	keyType := d.Get("type").(string)
+	pemBundle := d.Get("pem_bundle").(string)
+
+	if keyType == "" && pemBundle == "" {
+		return fmt.Errorf("one of type or pem_bundle is required")
+	}
+
+	managedKeyID := d.Get("managed_key_id").(string)
+	managedKeyName := d.Get("managed_key_name").(string)
+	if keyType == "kms" && managedKeyID == "" && managedKeyName == "" {
+		return fmt.Errorf("one of managed_key_id or managed_key_name is required when type is %q", keyType)
+	}
+	if keyType != "kms" && (managedKeyID != "" || managedKeyName != "") {
+		return fmt.Errorf("managed_key_id and managed_key_name are only valid when type is \"kms\"")
+	}
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("key_name"); ok {
+		data["key_name"] = v
+	}
+
+	var path string
+	if pemBundle != "" {
+		path = backend + "/keys/import"
+		data["pem_bundle"] = pemBundle
+	} else {
+		path = backend + "/keys/generate/" + keyType
+		for _, k := range []string{"key_type", "key_bits", "managed_key_id", "managed_key_name"} {
+			if v, ok := d.GetOk(k); ok {
+				data[k] = v
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Creating key on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating key on PKI secret backend %q: %s", backend, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no key returned by PKI secret backend %q", backend)
+	}
+	log.Printf("[DEBUG] Created key on PKI secret backend %q", backend)
+
+	keyID, ok := resp.Data["key_id"].(string)
+	if !ok || keyID == "" {
+		return fmt.Errorf("no key_id returned by PKI secret backend %q", backend)
+	}
+	d.Set("private_key", resp.Data["private_key"])
+
+	d.SetId(pkiSecretBackendKeyPath(backend, keyID))
+
+	return pkiSecretBackendKeyRead(d, meta)
+}
+
+func pkiSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	if d.HasChange("key_name") {
+		data := map[string]interface{}{
+			"key_name": d.Get("key_name"),
+		}
+
+		log.Printf("[DEBUG] Updating PKI secret backend key %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error updating PKI secret backend key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated PKI secret backend key %q", path)
+	}
+
+	return pkiSecretBackendKeyRead(d, meta)
+}
+
+func pkiSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, keyID, err := pkiSecretBackendKeyFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for PKI secret backend key: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading PKI secret backend key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading PKI secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read PKI secret backend key %q", path)
+	if resp == nil {
+		log.Printf("[WARN] PKI secret backend key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("key_id", keyID)
+	d.Set("key_ref", keyID)
+
+	for _, k := range []string{"key_name", "key_type"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on PKI secret backend key %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting PKI secret backend key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting PKI secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted PKI secret backend key %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendKeyPath(backend, keyID string) string {
+	return strings.Trim(backend, "/") + "/key/" + strings.Trim(keyID, "/")
+}
+
+func pkiSecretBackendKeyFromPath(path string) (string, string, error) {
+	idx := strings.LastIndex(path, "/key/")
+	if idx < 1 {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/key/<key_id>")
+	}
+
+	keyID := path[idx+len("/key/"):]
+	if keyID == "" || strings.Contains(keyID, "/") {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/key/<key_id>")
+	}
+
+	return path[:idx], keyID, nil
+}
@@ -0,0 +1,120 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPkiSecretBackendKey_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendKeyConfig(backend, "exported", "key-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "type", "exported"),
+					resource.TestCheckResourceAttr(resourceName, "key_name", "key-1"),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "ec"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_ref", resourceName, "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendKeyConfig(backend, "exported", "key-2"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "key_name", "key-2"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"type", "key_bits", "private_key"},
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_key" "exported" {
+  backend  = "${vault_pki_secret_backend.test.path}"
+  type     = "exported"
+  key_type = "ec"
+  key_bits = 256
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend    = "${vault_pki_secret_backend.test.path}"
+  key_name   = "imported"
+  pem_bundle = "${vault_pki_secret_backend_key.exported.private_key}"
+}
+`, backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key_name", "imported"),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "ec"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend  = "${vault_pki_secret_backend.test.path}"
+  key_type = "ec"
+}
+`, backend),
+				ExpectError: regexp.MustCompile("one of type or pem_bundle is required"),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendKeyDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_pki_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for PKI secret backend key %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("PKI secret backend key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testPkiSecretBackendKeyConfig(backend, keyType, keyName string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend  = "${vault_pki_secret_backend.test.path}"
+  type     = "%s"
+  key_name = "%s"
+  key_type = "ec"
+  key_bits = 256
+}
+`, backend, keyType, keyName)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -305,12 +306,13 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Default:     false,
 			},
 			"not_before_duration": {
-				Type:         schema.TypeString,
-				Required:     false,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Specifies the duration by which to backdate the NotBefore property.",
-				ValidateFunc: validateDuration,
+				Type:             schema.TypeString,
+				Required:         false,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Specifies the duration by which to backdate the NotBefore property.",
+				ValidateFunc:     validateDurationSecond,
+				DiffSuppressFunc: util.DurationDiffSuppress,
 			},
 		},
 	}
@@ -382,7 +384,10 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		"no_store":                           d.Get("no_store"),
 		"require_cn":                         d.Get("require_cn"),
 		"basic_constraints_valid_for_non_ca": d.Get("basic_constraints_valid_for_non_ca"),
-		"not_before_duration":                d.Get("not_before_duration"),
+	}
+
+	if v, ok := d.GetOk("not_before_duration"); ok {
+		data["not_before_duration"] = v
 	}
 
 	if len(allowedDomains) > 0 {
@@ -577,7 +582,10 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		"no_store":                           d.Get("no_store"),
 		"require_cn":                         d.Get("require_cn"),
 		"basic_constraints_valid_for_non_ca": d.Get("basic_constraints_valid_for_non_ca"),
-		"not_before_duration":                d.Get("not_before_duration"),
+	}
+
+	if v, ok := d.GetOk("not_before_duration"); ok {
+		data["not_before_duration"] = v
 	}
 
 	if len(allowedDomains) > 0 {
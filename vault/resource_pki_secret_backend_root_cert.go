@@ -27,9 +27,23 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Type of intermediate to create. Must be either \"exported\" or \"internal\".",
+				Description:  "Type of intermediate to create. Must be either \"exported\", \"internal\" or \"kms\".",
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"exported", "internal"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "kms"}, false),
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The ID of the managed key to use when type is \"kms\".",
+				ForceNew:      true,
+				ConflictsWith: []string{"managed_key_name"},
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The name of the managed key to use when type is \"kms\".",
+				ForceNew:      true,
+				ConflictsWith: []string{"managed_key_id"},
 			},
 			"common_name": {
 				Type:        schema.TypeString,
@@ -101,7 +115,7 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				Description:  "The desired key type.",
 				ForceNew:     true,
 				Default:      "rsa",
-				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec", "any"}, false),
 			},
 			"key_bits": {
 				Type:        schema.TypeInt,
@@ -201,6 +215,15 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 
 	path := pkiSecretBackendIntermediateSetSignedReadPath(backend, rootType)
 
+	managedKeyID := d.Get("managed_key_id").(string)
+	managedKeyName := d.Get("managed_key_name").(string)
+	if rootType == "kms" && managedKeyID == "" && managedKeyName == "" {
+		return fmt.Errorf("one of managed_key_id or managed_key_name is required when type is %q", rootType)
+	}
+	if rootType != "kms" && (managedKeyID != "" || managedKeyName != "") {
+		return fmt.Errorf("managed_key_id and managed_key_name are only valid when type is \"kms\"")
+	}
+
 	iAltNames := d.Get("alt_names").([]interface{})
 	altNames := make([]string, 0, len(iAltNames))
 	for _, iAltName := range iAltNames {
@@ -269,6 +292,14 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 		data["permitted_dns_domains"] = strings.Join(permittedDNSDomains, ",")
 	}
 
+	if managedKeyID != "" {
+		data["managed_key_id"] = managedKeyID
+	}
+
+	if managedKeyName != "" {
+		data["managed_key_name"] = managedKeyName
+	}
+
 	log.Printf("[DEBUG] Creating root cert on PKI secret backend %q", backend)
 	resp, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -43,6 +44,28 @@ func TestPkiSecretBackendRootCertificate_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendRootCertificate_kmsRequiresManagedKey(t *testing.T) {
+	path := "pki-" + strconv.Itoa(acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRootCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendRootCertificateConfig_kms(path, ""),
+				ExpectError: regexp.MustCompile("one of managed_key_id or managed_key_name is required"),
+			},
+			{
+				Config: testPkiSecretBackendRootCertificateConfig_kms(path, `
+  managed_key_id = "id"
+  managed_key_name = "name"`),
+				ExpectError: regexp.MustCompile(`"managed_key_id": conflicts with managed_key_name`),
+			},
+		},
+	})
+}
+
 func testPkiSecretBackendRootCertificateDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -93,3 +116,21 @@ resource "vault_pki_secret_backend_root_cert" "test" {
   province = "test"
 }`, path)
 }
+
+func testPkiSecretBackendRootCertificateConfig_kms(path, managedKey string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+  description = "test"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend = "${vault_pki_secret_backend.test.path}"
+  type = "kms"
+  common_name = "test Root CA"
+  key_type = "any"
+  %s
+}`, path, managedKey)
+}
@@ -0,0 +1,194 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pluginSHA256Regexp = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+func pluginResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginWrite,
+		Read:   pluginRead,
+		Update: pluginWrite,
+		Delete: pluginDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the plugin, one of auth, secret or database.",
+				ValidateFunc: validation.StringInSlice([]string{"auth", "secret", "database"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Semantic version of the plugin, requires Vault 1.12+.",
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Command to execute the plugin, relative to the plugin directory.",
+			},
+			"args": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of arguments to pass to the plugin command.",
+			},
+			"env": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of environment variables to set when running the plugin, in the key=value format.",
+			},
+			"sha256": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "SHA256 sum of the plugin binary, hex encoded.",
+				ValidateFunc: validation.StringMatch(pluginSHA256Regexp, "must be a 64 characters hex string"),
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+			},
+		},
+	}
+}
+
+func pluginWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+	path := pluginCatalogPath(pluginType, name)
+
+	data := map[string]interface{}{
+		"command": d.Get("command").(string),
+		"args":    d.Get("args").([]interface{}),
+		"env":     d.Get("env").([]interface{}),
+		"sha256":  strings.ToLower(d.Get("sha256").(string)),
+	}
+	if version != "" {
+		data["version"] = version
+	}
+
+	log.Printf("[DEBUG] Registering plugin %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error registering plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Registered plugin %q", path)
+
+	d.SetId(pluginID(pluginType, name, version))
+
+	return pluginRead(d, meta)
+}
+
+func pluginRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType, name, version, err := pluginParseID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := pluginCatalogPath(pluginType, name)
+
+	log.Printf("[DEBUG] Reading plugin %q", path)
+	resp, err := client.Logical().ReadWithData(path, pluginVersionParams(version))
+	if err != nil {
+		return fmt.Errorf("error reading plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read plugin %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Plugin %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", pluginType)
+	d.Set("name", name)
+	d.Set("version", version)
+	d.Set("command", resp.Data["command"])
+	d.Set("sha256", resp.Data["sha256"])
+	if err := d.Set("args", resp.Data["args"]); err != nil {
+		return fmt.Errorf("error setting args for plugin %q: %s", path, err)
+	}
+	// The environment variables are not returned by Vault, so the
+	// configured value is kept as is.
+
+	return nil
+}
+
+func pluginDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType, name, version, err := pluginParseID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := pluginCatalogPath(pluginType, name)
+
+	log.Printf("[DEBUG] Deregistering plugin %q", path)
+	if _, err := client.Logical().DeleteWithData(path, pluginVersionParams(version)); err != nil {
+		return fmt.Errorf("error deregistering plugin %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deregistered plugin %q", path)
+
+	return nil
+}
+
+func pluginCatalogPath(pluginType, name string) string {
+	return "sys/plugins/catalog/" + pluginType + "/" + name
+}
+
+func pluginVersionParams(version string) map[string][]string {
+	if version == "" {
+		return nil
+	}
+	return map[string][]string{
+		"version": {version},
+	}
+}
+
+// pluginID returns the ID of the plugin, <type>/<name> or
+// <type>/<name>/<version> for versioned plugins.
+func pluginID(pluginType, name, version string) string {
+	id := pluginType + "/" + name
+	if version != "" {
+		id += "/" + version
+	}
+	return id
+}
+
+func pluginParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("invalid ID %q, expected <type>/<name> or <type>/<name>/<version>", id)
+	}
+
+	var version string
+	if len(parts) == 3 {
+		version = parts[2]
+	}
+
+	return parts[0], parts[1], version, nil
+}
@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pluginPinnedVersionResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginPinnedVersionWrite,
+		Read:   pluginPinnedVersionRead,
+		Update: pluginPinnedVersionWrite,
+		Delete: pluginPinnedVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the plugin, one of auth, secret or database.",
+				ValidateFunc: validation.StringInSlice([]string{"auth", "secret", "database"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Version of the plugin that all its mounts run.",
+			},
+		},
+	}
+}
+
+func pluginPinnedVersionWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	path := pluginPinnedVersionPath(pluginType, name)
+
+	log.Printf("[DEBUG] Pinning plugin version %q", path)
+	data := map[string]interface{}{
+		"version": d.Get("version").(string),
+	}
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error pinning plugin version %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Pinned plugin version %q", path)
+
+	d.SetId(pluginID(pluginType, name, ""))
+
+	if err := pluginReload(client, name); err != nil {
+		return err
+	}
+
+	return pluginPinnedVersionRead(d, meta)
+}
+
+func pluginPinnedVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType, name, version, err := pluginParseID(d.Id())
+	if err != nil || version != "" {
+		return fmt.Errorf("invalid ID %q, expected <type>/<name>", d.Id())
+	}
+	path := pluginPinnedVersionPath(pluginType, name)
+
+	log.Printf("[DEBUG] Reading pinned plugin version %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading pinned plugin version %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read pinned plugin version %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Pinned plugin version %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", pluginType)
+	d.Set("name", name)
+	d.Set("version", resp.Data["version"])
+
+	return nil
+}
+
+func pluginPinnedVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType, name, _, err := pluginParseID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := pluginPinnedVersionPath(pluginType, name)
+
+	log.Printf("[DEBUG] Removing pinned plugin version %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error removing pinned plugin version %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed pinned plugin version %q", path)
+
+	return pluginReload(client, name)
+}
+
+func pluginPinnedVersionPath(pluginType, name string) string {
+	return "sys/plugins/pins/" + pluginType + "/" + name
+}
+
+// pluginReload reloads all the mounts of the plugin name, so that they run
+// its pinned version.
+func pluginReload(client *api.Client, name string) error {
+	log.Printf("[DEBUG] Reloading plugin %q", name)
+	data := map[string]interface{}{
+		"plugin": name,
+	}
+	if _, err := client.Logical().Write("sys/plugins/reload/backend", data); err != nil {
+		return fmt.Errorf("error reloading plugin %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Reloaded plugin %q", name)
+
+	return nil
+}
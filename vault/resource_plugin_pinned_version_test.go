@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourcePluginPinnedVersion(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-plugin")
+	resName := "vault_plugin_pinned_version.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheckVaultVersion(t, "1.16.0") },
+		CheckDestroy: testResourcePluginPinnedVersionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePluginPinnedVersion_config(name, "v1.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "secret/"+name),
+					resource.TestCheckResourceAttr(resName, "type", "secret"),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "version", "v1.0.0"),
+				),
+			},
+			{
+				Config: testResourcePluginPinnedVersion_config(name, "v1.1.0"),
+				Check:  resource.TestCheckResourceAttr(resName, "version", "v1.1.0"),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourcePluginPinnedVersionCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin_pinned_version" {
+			continue
+		}
+		pluginType, name, _, err := pluginParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Logical().Read(pluginPinnedVersionPath(pluginType, name))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("plugin version %q is still pinned", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourcePluginPinnedVersion_config(name, version string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "v1_0" {
+  type    = "secret"
+  name    = "%[1]s"
+  command = "%[1]s"
+  version = "v1.0.0"
+  sha256  = "%[2]s"
+}
+
+resource "vault_plugin" "v1_1" {
+  type    = "secret"
+  name    = "%[1]s"
+  command = "%[1]s"
+  version = "v1.1.0"
+  sha256  = "%[2]s"
+}
+
+resource "vault_plugin_pinned_version" "test" {
+  type    = "secret"
+  name    = "%[1]s"
+  version = "%[3]s"
+
+  depends_on = [vault_plugin.v1_0, vault_plugin.v1_1]
+}
+`, name, testPluginSHA256, version)
+}
+
+func TestPluginPinnedVersionDelete(t *testing.T) {
+	var requests []string
+	var reloaded interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/v1/sys/plugins/reload/backend" {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("unexpected request body: %s", err)
+			}
+			reloaded = body["plugin"]
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := pluginPinnedVersionResource().TestResourceData()
+	d.SetId("secret/foo")
+	if err := pluginPinnedVersionDelete(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"DELETE /v1/sys/plugins/pins/secret/foo",
+		"PUT /v1/sys/plugins/reload/backend",
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	if reloaded != "foo" {
+		t.Fatalf("expected plugin %q to be reloaded, got %v", "foo", reloaded)
+	}
+}
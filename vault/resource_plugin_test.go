@@ -0,0 +1,118 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+const testPluginSHA256 = "0e4f0c3a9a4d4be1b7b4b8fbbdc5ff4c2c7b4e0e8b0a1c2d3e4f5a6b7c8d9e0f"
+
+func TestResourcePlugin(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-plugin")
+	resName := "vault_plugin.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourcePluginCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePlugin_config(name, "--debug"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "secret/"+name),
+					resource.TestCheckResourceAttr(resName, "type", "secret"),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "command", name),
+					resource.TestCheckResourceAttr(resName, "sha256", testPluginSHA256),
+					resource.TestCheckResourceAttr(resName, "args.#", "1"),
+					resource.TestCheckResourceAttr(resName, "args.0", "--debug"),
+				),
+			},
+			{
+				Config: testResourcePlugin_config(name, "--verbose"),
+				Check:  resource.TestCheckResourceAttr(resName, "args.0", "--verbose"),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"env"},
+			},
+		},
+	})
+}
+
+func testResourcePluginCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin" {
+			continue
+		}
+		pluginType, name, version, err := pluginParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Logical().ReadWithData(pluginCatalogPath(pluginType, name), pluginVersionParams(version))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("plugin %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourcePlugin_config(name, arg string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "test" {
+  type    = "secret"
+  name    = "%s"
+  command = "%s"
+  args    = ["%s"]
+  env     = ["FOO=bar"]
+  sha256  = "%s"
+}
+`, name, name, arg, testPluginSHA256)
+}
+
+func TestPluginParseID(t *testing.T) {
+	tests := []struct {
+		id      string
+		typ     string
+		name    string
+		version string
+		wantErr bool
+	}{
+		{id: "secret/foo", typ: "secret", name: "foo"},
+		{id: "database/foo/v1.0.0", typ: "database", name: "foo", version: "v1.0.0"},
+		{id: "foo", wantErr: true},
+		{id: "auth/", wantErr: true},
+		{id: "auth/foo/v1/bar", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		typ, name, version, err := pluginParseID(tt.id)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("expected an error for %q", tt.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", tt.id, err)
+			continue
+		}
+		if typ != tt.typ || name != tt.name || version != tt.version {
+			t.Errorf("expected %q to be parsed as %q, %q, %q, got %q, %q, %q", tt.id, tt.typ, tt.name, tt.version, typ, name, version)
+		}
+		if id := pluginID(typ, name, version); id != tt.id {
+			t.Errorf("expected ID %q, got %q", tt.id, id)
+		}
+	}
+}
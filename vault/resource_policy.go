@@ -3,7 +3,10 @@ package vault
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
 
+	"github.com/hashicorp/hcl"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
@@ -27,9 +30,10 @@ func policyResource() *schema.Resource {
 			},
 
 			"policy": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The policy document",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The policy document",
+				DiffSuppressFunc: policyDiffSuppress,
 			},
 		},
 	}
@@ -84,3 +88,84 @@ func policyRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// policyDiffSuppress suppresses the diff between two policy documents when
+// they are semantically equal, ignoring formatting, comments, and the
+// ordering of path blocks and capabilities.
+func policyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldPolicy, err := normalizePolicy(old)
+	if err != nil {
+		return false
+	}
+
+	newPolicy, err := normalizePolicy(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldPolicy, newPolicy)
+}
+
+// normalizePolicy parses the HCL policy document into a canonical
+// representation that can be compared for equality.
+func normalizePolicy(policy string) (interface{}, error) {
+	var raw interface{}
+	if err := hcl.Decode(&raw, policy); err != nil {
+		return nil, err
+	}
+
+	return normalizePolicyValue(raw), nil
+}
+
+func normalizePolicyValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			m[k] = normalizePolicyValue(val)
+		}
+		return m
+	case []map[string]interface{}:
+		// HCL decodes repeated blocks, e.g. multiple path stanzas, as a list
+		// of single key maps. Merge them so that their ordering does not
+		// matter, unless the same key is declared more than once.
+		m := make(map[string]interface{})
+		for _, item := range v {
+			for k, val := range item {
+				if _, ok := m[k]; ok {
+					l := make([]interface{}, 0, len(v))
+					for _, item := range v {
+						l = append(l, normalizePolicyValue(item))
+					}
+					return l
+				}
+				m[k] = normalizePolicyValue(val)
+			}
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, 0, len(v))
+		strs := make([]string, 0, len(v))
+		for _, val := range v {
+			if s, ok := val.(string); ok {
+				strs = append(strs, s)
+			}
+			l = append(l, normalizePolicyValue(val))
+		}
+		if len(strs) == len(v) {
+			// Lists of strings, e.g. capabilities, are unordered.
+			sort.Strings(strs)
+			l = make([]interface{}, 0, len(strs))
+			for _, s := range strs {
+				l = append(l, s)
+			}
+		}
+		return l
+	}
+
+	return v
+}
@@ -117,3 +117,85 @@ func testResourcePolicy_updateCheck(s *terraform.State) error {
 
 	return nil
 }
+
+func TestPolicyDiffSuppress(t *testing.T) {
+	base := `
+path "secret/*" {
+	capabilities = ["create", "read"]
+}
+
+path "auth/*" {
+	capabilities = ["read"]
+}
+`
+	tests := []struct {
+		name     string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			new:      base,
+			expected: true,
+		},
+		{
+			name: "formatting and comments",
+			new: `# allow access to secrets
+path "secret/*" { capabilities = ["create", "read"] }
+path "auth/*" {
+  capabilities = [
+    "read",
+  ]
+}`,
+			expected: true,
+		},
+		{
+			name: "path and capability ordering",
+			new: `
+path "auth/*" {
+	capabilities = ["read"]
+}
+
+path "secret/*" {
+	capabilities = ["read", "create"]
+}
+`,
+			expected: true,
+		},
+		{
+			name: "capability added",
+			new: `
+path "secret/*" {
+	capabilities = ["create", "read", "update"]
+}
+
+path "auth/*" {
+	capabilities = ["read"]
+}
+`,
+			expected: false,
+		},
+		{
+			name: "path removed",
+			new: `
+path "secret/*" {
+	capabilities = ["create", "read"]
+}
+`,
+			expected: false,
+		},
+		{
+			name:     "invalid",
+			new:      `path "secret/*" {`,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := policyDiffSuppress("policy", base, tt.new, nil); actual != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
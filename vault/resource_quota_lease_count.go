@@ -42,12 +42,36 @@ func quotaLeaseCountResource() *schema.Resource {
 				Required:     true,
 				ForceNew:     false,
 				Description:  "The maximum number of leases to be allowed by the quota rule. The max_leases must be positive.",
-				ValidateFunc: validation.IntAtLeast(0),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set on a quota where path is set to an auth mount with a concept of roles (such as /auth/approle/), this will make the quota restrict login requests to that mount that are made with the specified role.",
+			},
+			"inheritable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set to true on a quota where path is set to a namespace, the same quota will be cumulatively applied to all child namespace.",
 			},
 		},
 	}
 }
 
+func quotaLeaseCountUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
+	data["path"] = d.Get("path").(string)
+	data["max_leases"] = d.Get("max_leases").(int)
+
+	if v, ok := d.GetOk("role"); ok || d.HasChange("role") {
+		data["role"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("inheritable"); ok {
+		data["inheritable"] = v.(bool)
+	}
+}
+
 func quotaLeaseCountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -58,8 +82,7 @@ func quotaLeaseCountCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Creating Resource Lease Count Quota %s", name)
 
 	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["max_leases"] = d.Get("max_leases").(int)
+	quotaLeaseCountUpdateFields(d, data)
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -89,7 +112,7 @@ func quotaLeaseCountRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"path", "max_leases"} {
+	for _, k := range []string{"path", "max_leases", "role", "inheritable"} {
 		v, ok := resp.Data[k]
 		if ok {
 			if err := d.Set(k, v); err != nil {
@@ -110,8 +133,7 @@ func quotaLeaseCountUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating Resource Lease Count Quota %s", name)
 
 	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["max_leases"] = d.Get("max_leases").(int)
+	quotaLeaseCountUpdateFields(d, data)
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -54,6 +54,11 @@ func TestQuotaLeaseCount(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_quota_lease_count.foobar", "max_leases", newLeaseCount),
 				),
 			},
+			{
+				ResourceName:      "vault_quota_lease_count.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -78,6 +83,32 @@ func testQuotaLeaseCountCheckDestroy(leaseCounts []string) resource.TestCheckFun
 	}
 }
 
+func TestQuotaLeaseCount_role(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	name := acctest.RandomWithPrefix("tf-test")
+	backend := acctest.RandomWithPrefix("approle")
+	leaseCount := randomQuotaLeaseString()
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testQuotaLeaseCountCheckDestroy([]string{name}),
+		Steps: []resource.TestStep{
+			{
+				Config: testQuotaLeaseCount_ConfigRole(name, backend, leaseCount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_lease_count.foobar", "name", name),
+					resource.TestCheckResourceAttr("vault_quota_lease_count.foobar", "path", "auth/"+backend+"/"),
+					resource.TestCheckResourceAttr("vault_quota_lease_count.foobar", "max_leases", leaseCount),
+					resource.TestCheckResourceAttr("vault_quota_lease_count.foobar", "role", "test-role"),
+				),
+			},
+		},
+	})
+}
+
 // Caution: Don't set test max_leases values too low or other tests running concurrently might fail
 func testQuotaLeaseCount_Config(name, path, max_leases string) string {
 	return fmt.Sprintf(`
@@ -88,3 +119,24 @@ resource "vault_quota_lease_count" "foobar" {
 }
 `, name, path, max_leases)
 }
+
+func testQuotaLeaseCount_ConfigRole(name, backend, max_leases string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend = "${vault_auth_backend.approle.path}"
+  role_name = "test-role"
+}
+
+resource "vault_quota_lease_count" "foobar" {
+  name = "%s"
+  path = "auth/${vault_auth_backend.approle.path}/"
+  max_leases = %s
+  role = "${vault_approle_auth_backend_role.role.role_name}"
+}
+`, backend, name, max_leases)
+}
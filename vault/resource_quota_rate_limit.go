@@ -5,8 +5,9 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 func quotaRateLimitPath(name string) string {
@@ -38,15 +39,67 @@ func quotaRateLimitResource() *schema.Resource {
 				Description: "Path of the mount or namespace to apply the quota. A blank path configures a global rate limit quota.",
 			},
 			"rate": {
-				Type:         schema.TypeFloat,
-				Required:     true,
-				Description:  "The maximum number of requests at any given second to be allowed by the quota rule. The rate must be positive.",
-				ValidateFunc: validation.FloatAtLeast(0.0),
+				Type:        schema.TypeFloat,
+				Required:    true,
+				Description: "The maximum number of requests at any given second to be allowed by the quota rule. The rate must be positive.",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
+					if v.(float64) <= 0 {
+						errs = append(errs, fmt.Errorf("expected %s to be positive, got %v", k, v))
+					}
+					return
+				},
+			},
+			"interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The duration to enforce rate limiting for. Accepts a duration string or a number of seconds.",
+				ValidateFunc:     validateDurationSecond,
+				DiffSuppressFunc: util.DurationDiffSuppress,
+			},
+			"block_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "If set, when a client reaches a rate limit threshold, the client will be prohibited from any further requests until after the block interval has elapsed. Accepts a duration string or a number of seconds.",
+				ValidateFunc:     validateDurationSecond,
+				DiffSuppressFunc: util.DurationDiffSuppress,
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set on a quota where path is set to an auth mount with a concept of roles (such as /auth/approle/), this will make the quota restrict login requests to that mount that are made with the specified role.",
+			},
+			"inheritable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set to true on a quota where path is set to a namespace, the same quota will be cumulatively applied to all child namespace. Requires Vault Enterprise.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the quota.",
 			},
 		},
 	}
 }
 
+func quotaRateLimitUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
+	data["path"] = d.Get("path").(string)
+	data["rate"] = d.Get("rate").(float64)
+
+	for _, k := range []string{"interval", "block_interval", "role"} {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v.(string)
+		}
+	}
+
+	if v, ok := d.GetOkExists("inheritable"); ok {
+		data["inheritable"] = v.(bool)
+	}
+}
+
 func quotaRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -57,8 +110,7 @@ func quotaRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Creating Resource Rate Limit Quota %s", name)
 
 	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["rate"] = d.Get("rate").(float64)
+	quotaRateLimitUpdateFields(d, data)
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -88,7 +140,7 @@ func quotaRateLimitRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"path", "rate"} {
+	for _, k := range []string{"path", "rate", "role", "inheritable", "type"} {
 		v, ok := resp.Data[k]
 		if ok {
 			if err := d.Set(k, v); err != nil {
@@ -97,6 +149,14 @@ func quotaRateLimitRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	for _, k := range []string{"interval", "block_interval"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, flattenVaultDuration(v)); err != nil {
+				return fmt.Errorf("error setting %s for Resource Rate Limit Quota %s: %q", k, name, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -109,8 +169,7 @@ func quotaRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating Resource Rate Limit Quota %s", name)
 
 	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["rate"] = d.Get("rate").(float64)
+	quotaRateLimitUpdateFields(d, data)
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -53,6 +53,22 @@ func TestQuotaRateLimit(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "rate", newRateLimit),
 				),
 			},
+			{
+				Config: testQuotaRateLimit_ConfigIntervals(name, "sys/", newRateLimit, "30s", "2m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "name", name),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "path", "sys/"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "rate", newRateLimit),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "interval", "30s"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "block_interval", "2m"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "type", "rate-limit"),
+				),
+			},
+			{
+				ResourceName:      "vault_quota_rate_limit.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -87,3 +103,15 @@ resource "vault_quota_rate_limit" "foobar" {
 }
 `, name, path, rate)
 }
+
+func testQuotaRateLimit_ConfigIntervals(name, path, rate, interval, blockInterval string) string {
+	return fmt.Sprintf(`
+resource "vault_quota_rate_limit" "foobar" {
+  name           = "%s"
+  path           = "%s"
+  rate           = %s
+  interval       = "%s"
+  block_interval = "%s"
+}
+`, name, path, rate, interval, blockInterval)
+}
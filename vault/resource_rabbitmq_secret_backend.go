@@ -77,6 +77,17 @@ func rabbitmqSecretBackendResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Specifies whether to verify connection URI, username, and password.",
 			},
+			"password_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies a password policy to use when creating dynamic credentials. Defaults to generating an alphanumeric password if not set.",
+			},
+			"username_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Template describing how dynamic usernames are generated.",
+				ValidateFunc: validateUsernameTemplate,
+			},
 		},
 	}
 }
@@ -92,6 +103,8 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
 	verifyConnection := d.Get("verify_connection").(bool)
+	passwordPolicy := d.Get("password_policy").(string)
+	usernameTemplate := d.Get("username_template").(string)
 
 	d.Partial(true)
 	log.Printf("[DEBUG] Mounting Rabbitmq backend at %q", path)
@@ -120,6 +133,8 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 		"username":          username,
 		"password":          password,
 		"verify_connection": verifyConnection,
+		"password_policy":   passwordPolicy,
+		"username_template": usernameTemplate,
 	}
 	_, err = client.Logical().Write(path+"/config/connection", data)
 	if err != nil {
@@ -130,6 +145,8 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetPartial("username")
 	d.SetPartial("password")
 	d.SetPartial("verify_connection")
+	d.SetPartial("password_policy")
+	d.SetPartial("username_template")
 	d.Partial(false)
 	return rabbitmqSecretBackendRead(d, meta)
 }
@@ -156,8 +173,8 @@ func rabbitmqSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
 	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
 
-	// access key, secret key, and region, sadly, we can't read out
-	// the API doesn't support it
+	// the connection config, password policy and username template, sadly,
+	// can't be read out as the API doesn't support it
 	// So... if they drift, they drift.
 
 	return nil
@@ -182,13 +199,15 @@ func rabbitmqSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
 	}
-	if d.HasChange("connection_uri") || d.HasChange("username") || d.HasChange("password") || d.HasChange("verify_connection") {
+	if d.HasChanges("connection_uri", "username", "password", "verify_connection", "password_policy", "username_template") {
 		log.Printf("[DEBUG] Updating connecion credentials at %q", path+"/config/connection")
 		data := map[string]interface{}{
 			"connection_uri":    d.Get("connection_uri").(string),
 			"username":          d.Get("username").(string),
 			"password":          d.Get("password").(string),
 			"verify_connection": d.Get("verify_connection").(bool),
+			"password_policy":   d.Get("password_policy").(string),
+			"username_template": d.Get("username_template").(string),
 		}
 		_, err := client.Logical().Write(path+"/config/connection", data)
 		if err != nil {
@@ -199,6 +218,8 @@ func rabbitmqSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("username")
 		d.SetPartial("password")
 		d.SetPartial("verify_connection")
+		d.SetPartial("password_policy")
+		d.SetPartial("username_template")
 	}
 	d.Partial(false)
 	return rabbitmqSecretBackendRead(d, meta)
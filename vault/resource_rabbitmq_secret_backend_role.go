@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -69,6 +70,44 @@ func rabbitmqSecretBackendRoleResource() *schema.Resource {
 					},
 				},
 			},
+			"vhost_topics": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies a map of virtual hosts and exchanges to topic permissions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The vhost to set topic permissions for.",
+						},
+						"vhost": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The topic permissions of the exchanges of this vhost.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The topic exchange to set permissions for.",
+									},
+									"read": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The read permissions for this topic exchange.",
+									},
+									"write": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The write permissions for this topic exchange.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -111,6 +150,19 @@ func rabbitmqSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 		"tags":   tags,
 		"vhosts": string(vhostsJSON),
 	}
+
+	vhostTopics, err := expandRabbitmqVhostTopics(d.Get("vhost_topics").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if len(vhostTopics) > 0 || d.HasChange("vhost_topics") {
+		vhostTopicsJSON, err := json.Marshal(vhostTopics)
+		if err != nil {
+			return fmt.Errorf("error serializing vhost_topics: %s", err)
+		}
+		data["vhost_topics"] = string(vhostTopicsJSON)
+	}
+
 	log.Printf("[DEBUG] Creating role %q on Rabbitmq backend %q", name, backend)
 	_, err = client.Logical().Write(backend+"/roles/"+name, data)
 	if err != nil {
@@ -163,6 +215,13 @@ func rabbitmqSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("vhost", vhosts); err != nil {
 		return fmt.Errorf("Error setting vhosts in state: %s", err)
 	}
+	if v, ok := secret.Data["vhost_topics"].(map[string]interface{}); ok {
+		if err := d.Set("vhost_topics", flattenRabbitmqVhostTopics(v)); err != nil {
+			return fmt.Errorf("Error setting vhost_topics in state: %s", err)
+		}
+	} else {
+		d.Set("vhost_topics", nil)
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
@@ -193,3 +252,66 @@ func rabbitmqSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (
 	log.Printf("[DEBUG] Checked if %q exists", path)
 	return secret != nil, nil
 }
+
+// expandRabbitmqVhostTopics converts the vhost_topics blocks to the
+// vhost -> exchange -> permissions structure expected by Vault.
+func expandRabbitmqVhostTopics(vhostTopics []interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(vhostTopics))
+	for _, raw := range vhostTopics {
+		vhostTopic := raw.(map[string]interface{})
+		host := vhostTopic["host"].(string)
+		if _, ok := result[host]; ok {
+			return nil, fmt.Errorf("vhost_topics: duplicate host %q", host)
+		}
+
+		topics := map[string]interface{}{}
+		for _, rawTopic := range vhostTopic["vhost"].([]interface{}) {
+			topic := rawTopic.(map[string]interface{})
+			name := topic["topic"].(string)
+			if _, ok := topics[name]; ok {
+				return nil, fmt.Errorf("vhost_topics: duplicate topic %q for host %q", name, host)
+			}
+			topics[name] = map[string]interface{}{
+				"read":  topic["read"],
+				"write": topic["write"],
+			}
+		}
+		result[host] = topics
+	}
+	return result, nil
+}
+
+// flattenRabbitmqVhostTopics converts the vhost_topics returned by Vault to
+// the vhost_topics blocks, ordered by host and topic.
+func flattenRabbitmqVhostTopics(vhostTopics map[string]interface{}) []map[string]interface{} {
+	hosts := make([]string, 0, len(vhostTopics))
+	for host := range vhostTopics {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	result := make([]map[string]interface{}, 0, len(hosts))
+	for _, host := range hosts {
+		topics, _ := vhostTopics[host].(map[string]interface{})
+		names := make([]string, 0, len(topics))
+		for name := range topics {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		vhost := make([]map[string]interface{}, 0, len(names))
+		for _, name := range names {
+			perms, _ := topics[name].(map[string]interface{})
+			vhost = append(vhost, map[string]interface{}{
+				"topic": name,
+				"read":  perms["read"],
+				"write": perms["write"],
+			})
+		}
+		result = append(result, map[string]interface{}{
+			"host":  host,
+			"vhost": vhost,
+		})
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -118,6 +119,71 @@ func TestAccRabbitmqSecretBackendRole_nested(t *testing.T) {
 	})
 }
 
+func TestAccRabbitmqSecretBackendRole_vhostTopics(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-rabbitmq")
+	name := acctest.RandomWithPrefix("tf-test-rabbitmq")
+	connectionUri, username, password := getTestRMQCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccRabbitmqSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRabbitmqSecretBackendRoleConfig_vhostTopics(name, backend, connectionUri, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.#", "1"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.0.host", "/"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.0.vhost.#", "2"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.0.vhost.0.topic", "amq.topic"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.0.vhost.0.read", ".*"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.0.vhost.0.write", ""),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.0.vhost.1.topic", "events"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.0.vhost.1.read", "^orders\\."),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.0.vhost.1.write", "^orders\\."),
+				),
+			},
+			{
+				Config: testAccRabbitmqSecretBackendRoleConfig_basic(name, backend, connectionUri, username, password),
+				Check:  resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topics.#", "0"),
+			},
+		},
+	})
+}
+
+func TestRabbitmqVhostTopics(t *testing.T) {
+	vhostTopics := []interface{}{
+		map[string]interface{}{
+			"host": "/",
+			"vhost": []interface{}{
+				map[string]interface{}{"topic": "amq.topic", "read": ".*", "write": ""},
+			},
+		},
+	}
+
+	expanded, err := expandRabbitmqVhostTopics(vhostTopics)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"/": map[string]interface{}{
+			"amq.topic": map[string]interface{}{"read": ".*", "write": ""},
+		},
+	}
+	if !reflect.DeepEqual(expanded, expected) {
+		t.Errorf("expected %#v, got %#v", expected, expanded)
+	}
+
+	flattened := flattenRabbitmqVhostTopics(expected)
+	if len(flattened) != 1 || flattened[0]["host"] != "/" {
+		t.Fatalf("unexpected flattened vhost_topics %#v", flattened)
+	}
+
+	duplicate := append(vhostTopics, vhostTopics[0])
+	if _, err := expandRabbitmqVhostTopics(duplicate); err == nil {
+		t.Error("expected an error for duplicate hosts")
+	}
+}
+
 func testAccRabbitmqSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -187,3 +253,42 @@ resource "vault_rabbitmq_secret_backend_role" "test" {
 }
 `, path, connectionUri, username, password, name, testAccRabbitmqSecretBackendRoleTags_updated)
 }
+
+func testAccRabbitmqSecretBackendRoleConfig_vhostTopics(name, path, connectionUri, username, password string) string {
+	return fmt.Sprintf(`
+resource "vault_rabbitmq_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds = 86400
+  connection_uri = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "vault_rabbitmq_secret_backend_role" "test" {
+  backend = "${vault_rabbitmq_secret_backend.test.path}"
+  name = "%s"
+  tags = %q
+  vhost {
+    host = "/"
+    configure = ""
+    read = ".*"
+    write = ""
+  }
+  vhost_topics {
+    host = "/"
+    vhost {
+      topic = "amq.topic"
+      read = ".*"
+      write = ""
+    }
+    vhost {
+      topic = "events"
+      read = "^orders\\."
+      write = "^orders\\."
+    }
+  }
+}
+`, path, connectionUri, username, password, name, testAccRabbitmqSecretBackendRoleTags_basic)
+}
@@ -39,6 +39,7 @@ func TestAccRabbitmqSecretBackend_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "description", "test description"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "default_lease_ttl_seconds", "1800"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "max_lease_ttl_seconds", "43200"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "username_template", "{{ .DisplayName }}-{{ random 8 }}"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "connection_uri", connectionUri),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "username", username),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "password", password),
@@ -73,7 +74,7 @@ func TestAccRabbitmqSecretBackend_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// the API can't serve these fields, so ignore them
-				ImportStateVerifyIgnore: []string{"connection_uri", "username", "password", "verify_connection"},
+				ImportStateVerifyIgnore: []string{"connection_uri", "username", "password", "verify_connection", "password_policy", "username_template"},
 			},
 		},
 	})
@@ -125,5 +126,6 @@ resource "vault_rabbitmq_secret_backend" "test" {
   connection_uri = "%s"
   username = "%s"
   password = "%s"
+  username_template = "{{ .DisplayName }}-{{ random 8 }}"
 }`, path, connectionUri, username, password)
 }
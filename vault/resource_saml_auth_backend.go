@@ -0,0 +1,195 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var samlAuthBackendConfigFields = []string{
+	"idp_metadata_url",
+	"idp_sso_url",
+	"idp_entity_id",
+	"idp_cert",
+	"entity_id",
+	"default_role",
+	"verbose_logging",
+}
+
+func samlAuthBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: samlAuthBackendCreate,
+		Read:   samlAuthBackendRead,
+		Update: samlAuthBackendUpdate,
+		Delete: samlAuthBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "saml",
+				Description:  "Path where the auth backend is mounted.",
+				ValidateFunc: validateNoTrailingSlash,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the auth backend.",
+			},
+			"idp_metadata_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"idp_sso_url", "idp_entity_id", "idp_cert"},
+				Description:   "The metadata URL of the identity provider. Cannot be used with 'idp_sso_url', 'idp_entity_id' or 'idp_cert'.",
+			},
+			"idp_sso_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The SSO URL of the identity provider. Required if 'idp_metadata_url' is not set.",
+			},
+			"idp_entity_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The entity ID of the identity provider.",
+			},
+			"idp_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded certificate of the identity provider used to verify response and assertion signatures.",
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The entity ID of the SAML authentication service provider.",
+			},
+			"acs_urls": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The well-formatted URLs of the Assertion Consumer Service (ACS) that should receive a response from the identity provider.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The role to use if no role is provided during login.",
+			},
+			"verbose_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Log additional, potentially sensitive, information during the SAML exchange. Not recommended for production.",
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the auth backend.",
+			},
+		},
+	}
+}
+
+func samlAuthBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := strings.Trim(d.Get("path").(string), "/")
+
+	log.Printf("[DEBUG] Enabling SAML auth backend %q", path)
+	err := client.Sys().EnableAuthWithOptions(path, &api.EnableAuthOptions{
+		Type:        "saml",
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error enabling SAML auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Enabled SAML auth backend %q", path)
+
+	d.SetId(path)
+
+	return samlAuthBackendUpdate(d, meta)
+}
+
+func samlAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := samlAuthBackendConfigPath(d.Id())
+
+	data := map[string]interface{}{
+		"acs_urls": d.Get("acs_urls").([]interface{}),
+	}
+	for _, k := range samlAuthBackendConfigFields {
+		if v, ok := d.GetOkExists(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing SAML auth backend config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing SAML auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote SAML auth backend config %q", path)
+
+	return samlAuthBackendRead(d, meta)
+}
+
+func samlAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	log.Printf("[DEBUG] Reading SAML auth backend %q", id)
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading SAML auth backend %q: %s", id, err)
+	}
+	mount, ok := auths[id+"/"]
+	if !ok {
+		log.Printf("[WARN] SAML auth backend %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	path := samlAuthBackendConfigPath(id)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading SAML auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read SAML auth backend config %q", path)
+	if resp == nil {
+		log.Printf("[WARN] SAML auth backend config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", id)
+	d.Set("description", mount.Description)
+	d.Set("accessor", mount.Accessor)
+
+	for _, k := range append(samlAuthBackendConfigFields, "acs_urls") {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on SAML auth backend config %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func samlAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	return authMountDisable(meta.(*api.Client), d.Id())
+}
+
+func samlAuthBackendConfigPath(path string) string {
+	return "auth/" + strings.Trim(path, "/") + "/config"
+}
@@ -0,0 +1,229 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func samlAuthBackendRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "saml",
+			Description: "Path where the SAML auth backend is mounted.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"bound_subjects": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "The subjects being asserted for SAML authentication.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"bound_subjects_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"string", "glob"}, false),
+			Description:  "How to interpret values in 'bound_subjects': can be either \"string\" (exact match) or \"glob\" (wildcard match).",
+		},
+		"bound_attributes": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Map of attributes/values to match against. The expected value may be a single string or a comma-separated string list.",
+		},
+		"bound_attributes_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"string", "glob"}, false),
+			Description:  "How to interpret values in 'bound_attributes': can be either \"string\" (exact match) or \"glob\" (wildcard match).",
+		},
+		"groups_attribute": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The attribute to use to identify the set of groups to which the user belongs.",
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		Create: samlAuthBackendRoleCreate,
+		Read:   samlAuthBackendRoleRead,
+		Update: samlAuthBackendRoleUpdate,
+		Delete: samlAuthBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func samlAuthBackendRolePath(backend, name string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
+
+func samlAuthBackendRoleBackendFromPath(path string) (string, error) {
+	var parts = strings.Split(path, "/")
+	if len(parts) != 4 {
+		return "", fmt.Errorf("expected 4 parts in path '%s'", path)
+	}
+	return parts[1], nil
+}
+
+func samlAuthBackendRoleNameFromPath(path string) (string, error) {
+	var parts = strings.Split(path, "/")
+	if len(parts) != 4 {
+		return "", fmt.Errorf("expected 4 parts in path '%s'", path)
+	}
+	return parts[3], nil
+}
+
+func samlAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]interface{}, create bool) {
+	updateTokenFields(d, data, create)
+
+	data["bound_subjects"] = d.Get("bound_subjects").(*schema.Set).List()
+
+	boundAttributes := make(map[string]interface{})
+	for key, val := range d.Get("bound_attributes").(map[string]interface{}) {
+		vals := strings.Split(val.(string), ",")
+		for i := range vals {
+			vals[i] = strings.TrimSpace(vals[i])
+		}
+		boundAttributes[key] = vals
+	}
+	data["bound_attributes"] = boundAttributes
+
+	for _, k := range []string{"bound_subjects_type", "bound_attributes_type", "groups_attribute"} {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+}
+
+func samlAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := samlAuthBackendRolePath(d.Get("path").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{}
+	samlAuthBackendRoleUpdateFields(d, data, true)
+
+	log.Printf("[DEBUG] Writing SAML auth backend role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote SAML auth backend role %q", path)
+
+	d.SetId(path)
+
+	return samlAuthBackendRoleRead(d, meta)
+}
+
+func samlAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	data := map[string]interface{}{}
+	samlAuthBackendRoleUpdateFields(d, data, false)
+
+	log.Printf("[DEBUG] Updating SAML auth backend role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated SAML auth backend role %q", path)
+
+	return samlAuthBackendRoleRead(d, meta)
+}
+
+func samlAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := samlAuthBackendRoleBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for SAML auth backend role: %s", path, err)
+	}
+	name, err := samlAuthBackendRoleNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for SAML auth backend role: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading SAML auth backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read SAML auth backend role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] SAML auth backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", backend)
+	d.Set("name", name)
+
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
+
+	for _, k := range []string{"bound_subjects", "bound_subjects_type", "bound_attributes_type", "groups_attribute"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on SAML auth backend role %q: %s", k, path, err)
+			}
+		}
+	}
+
+	if v, ok := resp.Data["bound_attributes"].(map[string]interface{}); ok {
+		boundAttributes := make(map[string]interface{})
+		for k, val := range v {
+			switch attrVal := val.(type) {
+			case []interface{}:
+				boundAttributes[k] = strings.Join(util.JsonStringArrayToStringArray(attrVal), ",")
+			case string:
+				boundAttributes[k] = attrVal
+			default:
+				return fmt.Errorf("bound attribute is not a string or list: %v", val)
+			}
+		}
+		if err := d.Set("bound_attributes", boundAttributes); err != nil {
+			return fmt.Errorf("error setting state key \"bound_attributes\" on SAML auth backend role %q: %s", path, err)
+		}
+	}
+
+	return nil
+}
+
+func samlAuthBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting SAML auth backend role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted SAML auth backend role %q", path)
+
+	return nil
+}
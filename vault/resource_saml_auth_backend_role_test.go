@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccSAMLAuthBackendRole_basic(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	path := acctest.RandomWithPrefix("saml")
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "vault_saml_auth_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckSAMLAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSAMLAuthBackendRoleConfig_basic(path, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "name", role),
+					resource.TestCheckResourceAttr(resourceName, "bound_subjects.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bound_subjects_type", "string"),
+					resource.TestCheckResourceAttr(resourceName, "token_policies.#", "1"),
+				),
+			},
+			{
+				Config: testAccSAMLAuthBackendRoleConfig_full(path, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bound_subjects.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bound_subjects_type", "glob"),
+					resource.TestCheckResourceAttr(resourceName, "bound_attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bound_attributes.group", "admins,devs"),
+					resource.TestCheckResourceAttr(resourceName, "bound_attributes.department", "engineering"),
+					resource.TestCheckResourceAttr(resourceName, "groups_attribute", "groups"),
+					resource.TestCheckResourceAttr(resourceName, "token_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "token_policies.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSAMLAuthBackendRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_saml_auth_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for SAML auth backend role %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("SAML auth backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSAMLAuthBackendRoleConfig_basic(path, role string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_saml_auth_backend_role" "test" {
+  path           = "${vault_saml_auth_backend.test.path}"
+  name           = "%s"
+  bound_subjects = ["*@example.com"]
+  token_policies = ["default"]
+}
+`, testAccSAMLAuthBackendConfig(path, role), role)
+}
+
+func testAccSAMLAuthBackendRoleConfig_full(path, role string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "vault_saml_auth_backend_role" "test" {
+  path                = "${vault_saml_auth_backend.test.path}"
+  name                = "%s"
+  bound_subjects      = ["*@example.com", "*@example.org"]
+  bound_subjects_type = "glob"
+  bound_attributes = {
+    group      = "admins,devs"
+    department = "engineering"
+  }
+  groups_attribute = "groups"
+  token_ttl        = 3600
+  token_policies   = ["default", "dev"]
+}
+`, testAccSAMLAuthBackendConfig(path, role), role)
+}
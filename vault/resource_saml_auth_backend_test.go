@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccSAMLAuthBackend_basic(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	path := acctest.RandomWithPrefix("saml")
+	resourceName := "vault_saml_auth_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckSAMLAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSAMLAuthBackendConfig(path, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "idp_sso_url", "https://idp.example.com/sso"),
+					resource.TestCheckResourceAttr(resourceName, "idp_entity_id", "https://idp.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "entity_id", "https://vault.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "acs_urls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_role", "default"),
+					resource.TestCheckResourceAttr(resourceName, "verbose_logging", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "accessor"),
+				),
+			},
+			{
+				Config: testAccSAMLAuthBackendConfig(path, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_role", "admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSAMLAuthBackendDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error checking for SAML auth backends: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_saml_auth_backend" {
+			continue
+		}
+		if _, ok := auths[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("SAML auth backend %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSAMLAuthBackendConfig(path, defaultRole string) string {
+	return fmt.Sprintf(`
+resource "vault_saml_auth_backend" "test" {
+  path          = "%s"
+  idp_sso_url   = "https://idp.example.com/sso"
+  idp_entity_id = "https://idp.example.com"
+  idp_cert      = <<EOF
+%s
+EOF
+  entity_id     = "https://vault.example.com"
+  acs_urls      = ["https://vault.example.com:8200/v1/auth/%s/callback"]
+  default_role  = "%s"
+}
+`, path, kubernetesCAcert, path, defaultRole)
+}
@@ -5,18 +5,44 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
+// sshSecretBackendCAKeyTypes are the types of signing key pairs Vault can
+// generate, including the aliases it accepts.
+var sshSecretBackendCAKeyTypes = []string{
+	"ssh-rsa", "rsa",
+	"ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521", "ec",
+	"ssh-ed25519", "ed25519",
+}
+
 func sshSecretBackendCAResource() *schema.Resource {
 	return &schema.Resource{
 		Create: sshSecretBackendCACreate,
 		Read:   sshSecretBackendCARead,
+		Update: sshSecretBackendCAUpdate,
 		Delete: sshSecretBackendCADelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customdiff.All(
+			// Catch what Vault would reject at plan time, as an update
+			// removes the current key pair before writing the new one and
+			// there is no way to get it back.
+			func(d *schema.ResourceDiff, meta interface{}) error {
+				if !d.NewValueKnown("key_type") || !d.NewValueKnown("key_bits") {
+					return nil
+				}
+				return sshSecretBackendCAValidateKeyBits(d.Get("key_type").(string), d.Get("key_bits").(int))
+			},
+			customdiff.ComputedIf("public_key", func(d *schema.ResourceDiff, meta interface{}) bool {
+				// A regenerated signing key comes with a new public key.
+				return d.Get("generate_signing_key").(bool) && (d.HasChange("rotate") || d.HasChange("key_type") || d.HasChange("key_bits"))
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -39,7 +65,6 @@ func sshSecretBackendCAResource() *schema.Resource {
 			"private_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Sensitive:   true,
 				Computed:    true,
 				Description: "Private key part the SSH CA key pair; required if generate_signing_key is false.",
@@ -47,10 +72,26 @@ func sshSecretBackendCAResource() *schema.Resource {
 			"public_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				Description: "Public key part the SSH CA key pair; required if generate_signing_key is false.",
 			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Type of the signing key pair generated by Vault, e.g. ssh-rsa, ecdsa-sha2-nistp256 or ssh-ed25519. Only used if generate_signing_key is true.",
+				ValidateFunc: validation.StringInSlice(sshSecretBackendCAKeyTypes, false),
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Number of bits of the signing key pair generated by Vault. Only used if generate_signing_key is true.",
+			},
+			"rotate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Regenerate the signing key pair when this value is toggled. Only used if generate_signing_key is true.",
+			},
 		},
 	}
 }
@@ -59,6 +100,66 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	backend := d.Get("backend").(string)
 
+	if err := sshSecretBackendCAWrite(client, backend, d); err != nil {
+		return err
+	}
+
+	d.SetId(backend)
+	return sshSecretBackendCARead(d, meta)
+}
+
+func sshSecretBackendCAUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	if d.HasChange("rotate") || d.HasChange("key_type") || d.HasChange("key_bits") || d.HasChange("private_key") || d.HasChange("public_key") {
+		// Vault refuses to overwrite a configured CA, so the old key pair
+		// has to be removed before the new one is written.
+		log.Printf("[DEBUG] Removing CA information on SSH backend %q before rewriting it", backend)
+		if _, err := client.Logical().Delete(backend + "/config/ca"); err != nil {
+			return fmt.Errorf("Error deleting CA configuration for SSH backend %q: %s", backend, err)
+		}
+		if err := sshSecretBackendCAWrite(client, backend, d); err != nil {
+			return err
+		}
+	}
+
+	return sshSecretBackendCARead(d, meta)
+}
+
+// sshSecretBackendCAValidateKeyBits returns an error if Vault cannot generate
+// a signing key pair of keyType with keyBits, 0 being the default size.
+func sshSecretBackendCAValidateKeyBits(keyType string, keyBits int) error {
+	var valid []int
+	switch keyType {
+	case "", "ssh-rsa", "rsa":
+		valid = []int{0, 2048, 3072, 4096}
+	case "ec":
+		valid = []int{0, 256, 384, 521}
+	case "ecdsa-sha2-nistp256":
+		valid = []int{0, 256}
+	case "ecdsa-sha2-nistp384":
+		valid = []int{0, 384}
+	case "ecdsa-sha2-nistp521":
+		valid = []int{0, 521}
+	case "ssh-ed25519", "ed25519":
+		valid = []int{0}
+	default:
+		return fmt.Errorf("unsupported key_type %q", keyType)
+	}
+
+	for _, bits := range valid {
+		if keyBits == bits {
+			return nil
+		}
+	}
+	if len(valid) == 1 {
+		return fmt.Errorf("key_bits cannot be set for key_type %q", keyType)
+	}
+	return fmt.Errorf("key_bits %d is not valid for key_type %q, expected one of %v", keyBits, keyType, valid[1:])
+}
+
+func sshSecretBackendCAWrite(client *api.Client, backend string, d *schema.ResourceData) error {
 	data := make(map[string]interface{})
 	if generateSigningKey, ok := d.Get("generate_signing_key").(bool); ok {
 		data["generate_signing_key"] = generateSigningKey
@@ -69,6 +170,18 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	if publicKey, ok := d.Get("public_key").(string); ok {
 		data["public_key"] = publicKey
 	}
+	if v, ok := d.GetOk("key_type"); ok {
+		data["key_type"] = v.(string)
+	}
+	if v, ok := d.GetOk("key_bits"); ok {
+		data["key_bits"] = v.(int)
+	}
+
+	if data["generate_signing_key"] == true {
+		// a generated key pair replaces whatever public key is in state
+		delete(data, "private_key")
+		delete(data, "public_key")
+	}
 
 	log.Printf("[DEBUG] Writing CA information on SSH backend %q", backend)
 	_, err := client.Logical().Write(backend+"/config/ca", data)
@@ -77,8 +190,7 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Written CA information on SSH backend %q", backend)
 
-	d.SetId(backend)
-	return sshSecretBackendCARead(d, meta)
+	return nil
 }
 
 func sshSecretBackendCARead(d *schema.ResourceData, meta interface{}) error {
@@ -109,7 +221,7 @@ func sshSecretBackendCARead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_key", secret.Data["public_key"])
 	d.Set("backend", backend)
 
-	// the API doesn't return private_key and generate_signing_key
+	// the API doesn't return private_key, generate_signing_key, key_type and key_bits
 	// So... if they drift, they drift.
 
 	return nil
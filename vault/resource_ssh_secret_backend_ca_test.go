@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -42,6 +44,75 @@ func TestAccSSHSecretBackendCA_provided(t *testing.T) {
 	})
 }
 
+func TestAccSSHSecretBackendCA_rotate(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	var publicKey string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckSSHSecretBackendCADestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendCAConfigRotate(backend, "ssh-rsa", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHSecretBackendCACheck(backend),
+					testAccSSHSecretBackendCAPublicKey(&publicKey, "ssh-rsa ", false),
+				),
+			},
+			{
+				Config: testAccSSHSecretBackendCAConfigRotate(backend, "ssh-rsa", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHSecretBackendCACheck(backend),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_ca.test", "rotate", "true"),
+					testAccSSHSecretBackendCAPublicKey(&publicKey, "ssh-rsa ", true),
+				),
+			},
+			{
+				Config: testAccSSHSecretBackendCAConfigRotate(backend, "ssh-ed25519", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHSecretBackendCACheck(backend),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_ca.test", "key_type", "ssh-ed25519"),
+					testAccSSHSecretBackendCAPublicKey(&publicKey, "ssh-ed25519 ", true),
+				),
+			},
+			{
+				// The invalid key size is caught before the current
+				// key pair is removed.
+				Config:      testAccSSHSecretBackendCAConfigKeyBits(backend, "ssh-ed25519", 2048),
+				ExpectError: regexp.MustCompile(`key_bits cannot be set for key_type "ssh-ed25519"`),
+			},
+			{
+				Config: testAccSSHSecretBackendCAConfigRotate(backend, "ssh-ed25519", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHSecretBackendCACheck(backend),
+					resource.TestCheckResourceAttrPtr("vault_ssh_secret_backend_ca.test", "public_key", &publicKey),
+				),
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendCAPublicKey(publicKey *string, prefix string, checkDifferent bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["vault_ssh_secret_backend_ca.test"]
+		if !ok {
+			return fmt.Errorf("resource not found in state")
+		}
+
+		newPublicKey := rs.Primary.Attributes["public_key"]
+		if !strings.HasPrefix(newPublicKey, prefix) {
+			return fmt.Errorf("expected public key to start with %q, got %q", prefix, newPublicKey)
+		}
+		if checkDifferent && newPublicKey == *publicKey {
+			return fmt.Errorf("expected public key to change but it did not")
+		}
+
+		*publicKey = newPublicKey
+		return nil
+	}
+}
+
 func TestAccSSHSecretBackend_import(t *testing.T) {
 	backend := "ssh-" + acctest.RandString(10)
 	resource.Test(t, resource.TestCase{
@@ -95,6 +166,68 @@ resource "vault_ssh_secret_backend_ca" "test" {
 }`, backend)
 }
 
+func testAccSSHSecretBackendCAConfigRotate(backend, keyType string, rotate bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+  description = "SSH Secret backend"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = "${vault_mount.test.path}"
+  generate_signing_key = true
+  key_type             = "%s"
+  rotate               = %t
+}`, backend, keyType, rotate)
+}
+
+func testAccSSHSecretBackendCAConfigKeyBits(backend, keyType string, keyBits int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+  description = "SSH Secret backend"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = "${vault_mount.test.path}"
+  generate_signing_key = true
+  key_type             = "%s"
+  key_bits             = %d
+  rotate               = true
+}`, backend, keyType, keyBits)
+}
+
+func TestSSHSecretBackendCAValidateKeyBits(t *testing.T) {
+	tests := []struct {
+		keyType string
+		keyBits int
+		wantErr bool
+	}{
+		{keyType: "", keyBits: 0},
+		{keyType: "ssh-rsa", keyBits: 4096},
+		{keyType: "rsa", keyBits: 1024, wantErr: true},
+		{keyType: "ec", keyBits: 384},
+		{keyType: "ec", keyBits: 2048, wantErr: true},
+		{keyType: "ecdsa-sha2-nistp256", keyBits: 256},
+		{keyType: "ecdsa-sha2-nistp256", keyBits: 384, wantErr: true},
+		{keyType: "ssh-ed25519", keyBits: 0},
+		{keyType: "ed25519", keyBits: 256, wantErr: true},
+		{keyType: "dsa", keyBits: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := sshSecretBackendCAValidateKeyBits(tt.keyType, tt.keyBits)
+		if tt.wantErr && err == nil {
+			t.Errorf("expected an error for key_type %q and key_bits %d", tt.keyType, tt.keyBits)
+		}
+		if !tt.wantErr && err != nil {
+			t.Errorf("unexpected error for key_type %q and key_bits %d: %s", tt.keyType, tt.keyBits, err)
+		}
+	}
+}
+
 func testAccSSHSecretBackendCAConfigProvided(backend string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -4,15 +4,25 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
 var (
 	sshSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	sshSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
+
+	// sshSecretBackendRoleKeyTypes are the key type names accepted by Vault in
+	// allowed_user_key_lengths, both the short and the SSH algorithm names.
+	sshSecretBackendRoleKeyTypes = []string{
+		"rsa", "dsa", "ecdsa", "ec", "ecdsa-sk", "ec-sk", "ed25519", "ed25519-sk",
+		"ssh-rsa", "ssh-dss", "ecdsa-sha2-nistp256", "ecdsa-sha2-nistp384", "ecdsa-sha2-nistp521",
+		"sk-ecdsa-sha2-nistp256@openssh.com", "ssh-ed25519", "sk-ssh-ed25519@openssh.com",
+	}
 )
 
 func sshSecretBackendRoleResource() *schema.Resource {
@@ -100,6 +110,12 @@ func sshSecretBackendRoleResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"default_user_template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies if default_user can be declared using identity template policies.",
+			},
 			"key_id_format": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -109,13 +125,17 @@ func sshSecretBackendRoleResource() *schema.Resource {
 				Required: true,
 			},
 			"allowed_user_key_lengths": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Description:  "Map of key types to the comma-separated key lengths allowed to be signed, 0 allowing any length.",
+				ValidateFunc: validateSSHRoleAllowedUserKeyLengths,
 			},
 			"algorithm_signer": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The signing algorithm to use for the signed certificates.",
+				ValidateFunc: validation.StringInSlice([]string{"default", "ssh-rsa", "rsa-sha2-256", "rsa-sha2-512"}, false),
 			},
 			"max_ttl": {
 				Type:     schema.TypeString,
@@ -185,12 +205,20 @@ func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		data["default_user"] = v.(string)
 	}
 
+	if v, ok := d.GetOkExists("default_user_template"); ok {
+		data["default_user_template"] = v.(bool)
+	}
+
 	if v, ok := d.GetOk("key_id_format"); ok {
 		data["key_id_format"] = v.(string)
 	}
 
 	if v, ok := d.GetOk("allowed_user_key_lengths"); ok {
-		data["allowed_user_key_lengths"] = v
+		lengths, err := expandSSHRoleAllowedUserKeyLengths(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		data["allowed_user_key_lengths"] = lengths
 	}
 
 	if v, ok := d.GetOk("algorithm_signer"); ok {
@@ -263,8 +291,17 @@ func sshSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("allowed_users_template", role.Data["allowed_users_template"])
 	d.Set("allowed_users", role.Data["allowed_users"])
 	d.Set("default_user", role.Data["default_user"])
+	if v, ok := role.Data["default_user_template"]; ok {
+		d.Set("default_user_template", v)
+	}
 	d.Set("key_id_format", role.Data["key_id_format"])
-	d.Set("allowed_user_key_lengths", role.Data["allowed_user_key_lengths"])
+	if v, ok := role.Data["allowed_user_key_lengths"].(map[string]interface{}); ok {
+		if err := d.Set("allowed_user_key_lengths", flattenSSHRoleAllowedUserKeyLengths(v)); err != nil {
+			return fmt.Errorf("error setting allowed_user_key_lengths for role %q: %s", path, err)
+		}
+	} else {
+		d.Set("allowed_user_key_lengths", nil)
+	}
 	d.Set("max_ttl", role.Data["max_ttl"])
 	d.Set("ttl", role.Data["ttl"])
 	d.Set("algorithm_signer", role.Data["algorithm_signer"])
@@ -324,3 +361,77 @@ func sshSecretBackendRoleBackendFromPath(path string) (string, error) {
 	}
 	return res[1], nil
 }
+
+func validateSSHRoleAllowedUserKeyLengths(v interface{}, k string) (ws []string, errs []error) {
+	for keyType, lengths := range v.(map[string]interface{}) {
+		if !sshSecretBackendRoleKeyType(keyType) {
+			errs = append(errs, fmt.Errorf("%s: unsupported key type %q, expected one of %s",
+				k, keyType, strings.Join(sshSecretBackendRoleKeyTypes, ", ")))
+			continue
+		}
+		if _, err := parseSSHRoleKeyLengths(lengths.(string)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid lengths for key type %q: %s", k, keyType, err))
+		}
+	}
+	return
+}
+
+func sshSecretBackendRoleKeyType(keyType string) bool {
+	for _, t := range sshSecretBackendRoleKeyTypes {
+		if t == keyType {
+			return true
+		}
+	}
+	return false
+}
+
+func parseSSHRoleKeyLengths(s string) ([]int, error) {
+	var lengths []int
+	for _, l := range strings.Split(s, ",") {
+		i, err := strconv.Atoi(strings.TrimSpace(l))
+		if err != nil {
+			return nil, fmt.Errorf("expected a comma-separated list of integers, got %q", s)
+		}
+		lengths = append(lengths, i)
+	}
+	return lengths, nil
+}
+
+// expandSSHRoleAllowedUserKeyLengths converts the comma-separated lengths of
+// each key type to what Vault expects. Single lengths are sent as integers,
+// as versions of Vault prior to 1.10 do not accept lists.
+func expandSSHRoleAllowedUserKeyLengths(m map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(m))
+	for keyType, v := range m {
+		lengths, err := parseSSHRoleKeyLengths(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed_user_key_lengths for key type %q: %s", keyType, err)
+		}
+		if len(lengths) == 1 {
+			result[keyType] = lengths[0]
+		} else {
+			result[keyType] = lengths
+		}
+	}
+	return result, nil
+}
+
+// flattenSSHRoleAllowedUserKeyLengths converts the lengths returned by Vault,
+// either a single number or a list of numbers per key type, to the
+// comma-separated form stored in the state.
+func flattenSSHRoleAllowedUserKeyLengths(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for keyType, v := range m {
+		switch lengths := v.(type) {
+		case []interface{}:
+			s := make([]string, 0, len(lengths))
+			for _, l := range lengths {
+				s = append(s, fmt.Sprint(l))
+			}
+			result[keyType] = strings.Join(s, ",")
+		default:
+			result[keyType] = fmt.Sprint(lengths)
+		}
+	}
+	return result
+}
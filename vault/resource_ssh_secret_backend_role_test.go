@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -62,6 +64,7 @@ func TestAccSSHSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "allowed_users_template", "true"),
 					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "allowed_users", "usr1,usr2"),
 					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_user", "usr"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_user_template", "true"),
 					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "key_id_format", "{{role_name}}-test"),
 					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "key_type", "ca"),
 					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "allowed_user_key_lengths.rsa", "1"),
@@ -74,6 +77,63 @@ func TestAccSSHSecretBackendRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSHSecretBackendRole_allowedUserKeyLengths(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test/ssh")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccSSHSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendRoleConfig_allowedUserKeyLengths(name, backend, `{ "rsa" = "2048,4096", "ed25519" = "0" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "allowed_user_key_lengths.%", "2"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "allowed_user_key_lengths.rsa", "2048,4096"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "allowed_user_key_lengths.ed25519", "0"),
+				),
+			},
+			{
+				Config:      testAccSSHSecretBackendRoleConfig_allowedUserKeyLengths(name, backend, `{ "rsa1" = "2048" }`),
+				ExpectError: regexp.MustCompile(`unsupported key type "rsa1"`),
+			},
+			{
+				Config:      testAccSSHSecretBackendRoleConfig_allowedUserKeyLengths(name, backend, `{ "rsa" = "big" }`),
+				ExpectError: regexp.MustCompile(`invalid lengths for key type "rsa"`),
+			},
+		},
+	})
+}
+
+func TestSSHRoleAllowedUserKeyLengths(t *testing.T) {
+	expanded, err := expandSSHRoleAllowedUserKeyLengths(map[string]interface{}{
+		"rsa":     "2048, 4096",
+		"ed25519": "0",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"rsa":     []int{2048, 4096},
+		"ed25519": 0,
+	}
+	if !reflect.DeepEqual(expanded, expected) {
+		t.Errorf("expected %#v, got %#v", expected, expanded)
+	}
+
+	flattened := flattenSSHRoleAllowedUserKeyLengths(map[string]interface{}{
+		"rsa":     []interface{}{2048, 4096},
+		"ed25519": 0,
+	})
+	expected = map[string]interface{}{
+		"rsa":     "2048,4096",
+		"ed25519": "0",
+	}
+	if !reflect.DeepEqual(flattened, expected) {
+		t.Errorf("expected %#v, got %#v", expected, flattened)
+	}
+}
+
 func TestAccSSHSecretBackendRoleOTP_basic(t *testing.T) {
 	backend := acctest.RandomWithPrefix("tf-test/ssh")
 	name := acctest.RandomWithPrefix("tf-test-role")
@@ -197,6 +257,7 @@ resource "vault_ssh_secret_backend_role" "test_role" {
         allowed_users_template   = true
         allowed_users            = "usr1,usr2"
 	default_user             = "usr"
+	default_user_template    = true
 	key_id_format            = "{{role_name}}-test"
 	key_type                 = "ca"
 	allowed_user_key_lengths = { "rsa" = 1 }
@@ -224,3 +285,20 @@ resource "vault_ssh_secret_backend_role" "test_role" {
 }
 `, path, name)
 }
+
+func testAccSSHSecretBackendRoleConfig_allowedUserKeyLengths(name, path, lengths string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "example" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "test_role" {
+  name                     = "%s"
+  backend                  = "${vault_mount.example.path}"
+  key_type                 = "ca"
+  allow_user_certificates  = true
+  allowed_user_key_lengths = %s
+}
+`, path, name, lengths)
+}
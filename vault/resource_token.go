@@ -3,11 +3,13 @@ package vault
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/encryption"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -321,6 +323,13 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("renewable", resp.Data["renewable"])
 	d.Set("display_name", strings.TrimPrefix(resp.Data["display_name"].(string), "token-"))
 	d.Set("num_uses", resp.Data["num_uses"])
+
+	if err := tokenSetDuration(d, "ttl", resp.Data["creation_ttl"]); err != nil {
+		return err
+	}
+	if err := tokenSetDuration(d, "explicit_max_ttl", resp.Data["explicit_max_ttl"]); err != nil {
+		return err
+	}
 	if _, ok := d.GetOk("pgp_key"); !ok {
 		d.Set("pgp_key", "")
 	}
@@ -364,16 +373,24 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 			increment = v.(int)
 		}
 
-		renewed, err := client.Auth().Token().Renew(id, increment)
+		// renew with a client holding the managed token, so that the token
+		// of the provider is never renewed instead.
+		renewClient, err := client.Clone()
+		if err != nil {
+			return fmt.Errorf("error cloning client: %s", err)
+		}
+		renewClient.SetToken(id)
+
+		renewed, err := renewClient.Auth().Token().RenewTokenAsSelf(id, increment)
 		if err != nil {
 			log.Printf("[DEBUG] Error renewing token, removing from state")
 			d.SetId("")
 			return nil
 		}
 
-		log.Printf("[DEBUG] Lease for token accessor %q renewed, new lease duration %d", id, renewed.Auth.LeaseDuration)
+		log.Printf("[DEBUG] Lease for token accessor %q renewed, new lease duration %d", accessor, renewed.Auth.LeaseDuration)
 
-		d.Set("lease_duration", renewed.Data["lease_duration"])
+		d.Set("lease_duration", renewed.Auth.LeaseDuration)
 		d.Set("lease_started", time.Now().Format(time.RFC3339))
 		d.Set("client_token", renewed.Auth.ClientToken)
 
@@ -415,6 +432,28 @@ func tokenExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	return resp != nil, nil
 }
 
+// tokenSetDuration sets the duration key from the number of seconds returned
+// by Vault. Keys that aren't configured are left alone, as are keys configured
+// with an equivalent duration, e.g. "1h" for 3600.
+func tokenSetDuration(d *schema.ResourceData, key string, v interface{}) error {
+	current := d.Get(key).(string)
+	if current == "" || v == nil {
+		return nil
+	}
+
+	seconds, err := strconv.ParseInt(fmt.Sprintf("%v", v), 10, 64)
+	if err != nil {
+		return fmt.Errorf("error parsing %s %q: %s", key, v, err)
+	}
+
+	duration := time.Duration(seconds) * time.Second
+	if configured, err := util.ParseDurationSecond(current); err == nil && configured == duration {
+		return nil
+	}
+
+	return d.Set(key, util.ShortDur(duration))
+}
+
 func tokenCheckLease(d *schema.ResourceData) bool {
 	accessor := d.Id()
 
@@ -55,6 +55,30 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 			DefaultFunc: tokenAuthBackendRoleEmptyStringSet,
 			Description: "List of disallowed policies for given role.",
 		},
+		"allowed_policies_glob": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "List of allowed policy glob patterns for given role.",
+		},
+		"disallowed_policies_glob": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "List of disallowed policy glob patterns for given role.",
+		},
+		"allowed_entity_aliases": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Set of allowed entity aliases for this role.",
+		},
 		"orphan": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -111,7 +135,7 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 		Delete: tokenAuthBackendRoleDelete,
 		Exists: tokenAuthBackendRoleExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: tokenAuthBackendRoleImport,
 		},
 		Schema: fields,
 	}
@@ -122,6 +146,9 @@ func tokenAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]in
 
 	data["allowed_policies"] = d.Get("allowed_policies").(*schema.Set).List()
 	data["disallowed_policies"] = d.Get("disallowed_policies").(*schema.Set).List()
+	data["allowed_policies_glob"] = d.Get("allowed_policies_glob").(*schema.Set).List()
+	data["disallowed_policies_glob"] = d.Get("disallowed_policies_glob").(*schema.Set).List()
+	data["allowed_entity_aliases"] = d.Get("allowed_entity_aliases").(*schema.Set).List()
 	data["orphan"] = d.Get("orphan").(bool)
 	data["renewable"] = d.Get("renewable").(bool)
 	data["path_suffix"] = d.Get("path_suffix").(string)
@@ -233,6 +260,15 @@ func tokenAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// these are only returned by the Vault versions that support them
+	for _, k := range []string{"allowed_policies_glob", "disallowed_policies_glob", "allowed_entity_aliases"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error reading %s for Token auth backend role %q: %q", k, path, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -283,6 +319,15 @@ func tokenAuthBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool,
 	return resp != nil, nil
 }
 
+// tokenAuthBackendRoleImport accepts either the role name or the full path of
+// the role, e.g. "auth/token/roles/<name>".
+func tokenAuthBackendRoleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !tokenAuthBackendRoleNameFromPathRegex.MatchString(d.Id()) {
+		d.SetId(tokenAuthBackendRolePath(d.Id()))
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func tokenAuthBackendRolePath(role string) string {
 	return "auth/token/roles/" + strings.Trim(role, "/")
 }
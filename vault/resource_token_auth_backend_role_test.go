@@ -29,6 +29,12 @@ func TestAccTokenAuthBackendRoleImport(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "vault_token_auth_backend_role.role",
+				ImportState:       true,
+				ImportStateId:     role,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -80,6 +86,8 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "token_bound_cidrs.#", "1"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "token_bound_cidrs.217649824", "0.0.0.0/0"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "token_type", "default-batch"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies_glob.#", "1"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_entity_aliases.#", "1"),
 				),
 			},
 			{
@@ -333,6 +341,8 @@ resource "vault_token_auth_backend_role" "role" {
   role_name = "%s"
   allowed_policies = ["dev", "test"]
   disallowed_policies = ["default"]
+  allowed_policies_glob = ["dev-*"]
+  allowed_entity_aliases = ["ci-*"]
   orphan = true
   token_period = "86400"
   renewable = false
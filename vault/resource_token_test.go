@@ -1,13 +1,18 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
-	"github.com/hashicorp/vault/api"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
 )
 
 func testResourceTokenCheckDestroy(s *terraform.State) error {
@@ -242,6 +247,7 @@ func TestResourceToken_renew(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testResourceTokenCheckExpireTime("vault_token.test"),
 					resource.TestCheckResourceAttr("vault_token.test", "ttl", "30s"),
+					resource.TestCheckResourceAttr("vault_token.test", "explicit_max_ttl", "1h"),
 					resource.TestCheckResourceAttr("vault_token.test", "renew_min_lease", "10"),
 					resource.TestCheckResourceAttr("vault_token.test", "renew_increment", "30"),
 					resource.TestCheckResourceAttr("vault_token.test", "lease_duration", "29"),
@@ -264,6 +270,18 @@ func TestResourceToken_renew(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_token.test", "encrypted_client_token", ""),
 				),
 			},
+			{
+				// The token was renewed by the refresh after the previous
+				// step, its lease now ends later than the one it was
+				// created with.
+				Config: testResourceTokenConfig_renew(),
+				Check: resource.ComposeTestCheckFunc(
+					testResourceTokenCheckExpireTime("vault_token.test"),
+					testResourceTokenCheckRenewed("vault_token.test", 29),
+					resource.TestCheckResourceAttr("vault_token.test", "ttl", "30s"),
+					resource.TestCheckResourceAttr("vault_token.test", "explicit_max_ttl", "1h"),
+				),
+			},
 			{
 				Config:  testResourceTokenConfig_renew(),
 				Destroy: true,
@@ -273,6 +291,7 @@ func TestResourceToken_renew(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testResourceTokenCheckExpireTime("vault_token.test"),
 					resource.TestCheckResourceAttr("vault_token.test", "ttl", "30s"),
+					resource.TestCheckResourceAttr("vault_token.test", "explicit_max_ttl", "1h"),
 					resource.TestCheckResourceAttr("vault_token.test", "renew_min_lease", "10"),
 					resource.TestCheckResourceAttr("vault_token.test", "renew_increment", "30"),
 					resource.TestCheckResourceAttr("vault_token.test", "lease_duration", "29"),
@@ -298,6 +317,7 @@ resource "vault_token" "test" {
 	policies = [ "${vault_policy.test.name}" ]
 	renewable = true
 	ttl = "30s"
+	explicit_max_ttl = "1h"
 	renew_min_lease = 10
 	renew_increment = 30
 }`
@@ -432,6 +452,172 @@ func testResourceTokenWaitRenewMinLeaseTime(n string) resource.TestCheckFunc {
 	}
 }
 
+func testResourceTokenCheckRenewed(n string, createdLeaseDuration int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		leaseDuration, err := strconv.Atoi(rs.Primary.Attributes["lease_duration"])
+		if err != nil {
+			return fmt.Errorf("Invalid lease_duration value: %s", err)
+		}
+
+		if leaseDuration <= createdLeaseDuration {
+			return fmt.Errorf("Expected lease_duration to be greater than %d after renewal, got %d", createdLeaseDuration, leaseDuration)
+		}
+
+		return nil
+	}
+}
+
+func TestTokenSetDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "hours",
+			current:  "1h",
+			value:    json.Number("3600"),
+			expected: "1h",
+		},
+		{
+			name:     "minutes",
+			current:  "60m",
+			value:    json.Number("3600"),
+			expected: "60m",
+		},
+		{
+			name:     "seconds",
+			current:  "3600s",
+			value:    json.Number("3600"),
+			expected: "3600s",
+		},
+		{
+			name:     "plain-seconds",
+			current:  "3600",
+			value:    json.Number("3600"),
+			expected: "3600",
+		},
+		{
+			name:     "changed",
+			current:  "1h",
+			value:    json.Number("7200"),
+			expected: "2h",
+		},
+		{
+			name:     "not-set",
+			current:  "",
+			value:    json.Number("3600"),
+			expected: "",
+		},
+		{
+			name:     "not-returned",
+			current:  "1h",
+			expected: "1h",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tokenResource().TestResourceData()
+			d.Set("ttl", tt.current)
+
+			if err := tokenSetDuration(d, "ttl", tt.value); err != nil {
+				t.Fatal(err)
+			}
+			if got := d.Get("ttl").(string); got != tt.expected {
+				t.Fatalf("expected ttl %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	d := tokenResource().TestResourceData()
+	d.Set("ttl", "1h")
+	if err := tokenSetDuration(d, "ttl", "invalid"); err == nil {
+		t.Fatal("expected an error for an invalid duration")
+	}
+}
+
+func TestTokenRead_renew(t *testing.T) {
+	now := time.Now()
+	var renewToken string
+	var renewIncrement interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-accessor":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{
+					"policies":         []string{"default", "test"},
+					"orphan":           false,
+					"renewable":        true,
+					"display_name":     "token",
+					"num_uses":         0,
+					"creation_ttl":     30,
+					"explicit_max_ttl": 3600,
+					"issue_time":       now.Add(-25 * time.Second).Format(time.RFC3339Nano),
+					"expire_time":      now.Add(5 * time.Second).Format(time.RFC3339Nano),
+				},
+			})
+		case "/v1/auth/token/renew-self":
+			renewToken = r.Header.Get(consts.AuthHeaderName)
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("unexpected request body: %s", err)
+			}
+			renewIncrement = body["increment"]
+			fmt.Fprint(w, `{"auth":{"client_token":"managed","accessor":"accessor","lease_duration":42}}`)
+		default:
+			t.Errorf("unexpected request path %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("provider")
+
+	d := tokenResource().TestResourceData()
+	d.SetId("accessor")
+	d.Set("client_token", "managed")
+	d.Set("ttl", "30s")
+	d.Set("explicit_max_ttl", "1h")
+	d.Set("renew_min_lease", 10)
+	d.Set("renew_increment", 30)
+
+	if err := tokenRead(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	if renewToken != "managed" {
+		t.Fatalf("expected the token to be renewed as itself, got token %q", renewToken)
+	}
+	if fmt.Sprint(renewIncrement) != "30" {
+		t.Fatalf("expected increment 30, got %v", renewIncrement)
+	}
+	if d.Id() != "accessor" {
+		t.Fatalf("expected id %q, got %q", "accessor", d.Id())
+	}
+	if got := d.Get("lease_duration").(int); got != 42 {
+		t.Fatalf("expected lease_duration 42 from the renewed auth, got %d", got)
+	}
+	if got := d.Get("ttl").(string); got != "30s" {
+		t.Fatalf("expected ttl %q, got %q", "30s", got)
+	}
+	if got := d.Get("explicit_max_ttl").(string); got != "1h" {
+		t.Fatalf("expected explicit_max_ttl %q, got %q", "1h", got)
+	}
+}
+
 func TestResourceToken_pgp(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Providers:    testProviders,
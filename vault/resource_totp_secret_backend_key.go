@@ -0,0 +1,260 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: totpSecretBackendKeyCreate,
+		Read:   totpSecretBackendKeyRead,
+		Delete: totpSecretBackendKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the TOTP secret backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the key.",
+			},
+			"generate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether Vault generates the key, acting as a TOTP provider, instead of importing it from url or key.",
+			},
+			"exported": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the generated key is returned in the url and barcode attributes. Only used if generate is true.",
+			},
+			"key_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     20,
+				Description: "Size in bytes of the generated key. Only used if generate is true.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The otpauth URL of the key. Imported when generate is false, returned by Vault when generate and exported are true.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The root key to import, in base32. Only used if generate is false.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the key's issuing organization.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the account associated with the key.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The length of time in seconds used to generate a counter for the TOTP code calculation.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The hashing algorithm used to generate the TOTP code.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The number of digits in the generated TOTP code.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				Description:  "The number of delay periods that are allowed when validating a TOTP code. Only used if generate is true.",
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"qr_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     200,
+				Description: "The pixel size of the generated square QR code, 0 disabling it. Only used if generate and exported are true.",
+			},
+			"barcode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded PNG QR code of the url, only set if generate and exported are true.",
+			},
+		},
+	}
+}
+
+func totpSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	generate := d.Get("generate").(bool)
+	url := d.Get("url").(string)
+	key := d.Get("key").(string)
+
+	if generate && (url != "" || key != "") {
+		return fmt.Errorf("url and key cannot be set when generate is true")
+	}
+	if !generate && url == "" && key == "" {
+		return fmt.Errorf("one of url or key is required when generate is false")
+	}
+
+	path := totpSecretBackendKeyPath(backend, name)
+
+	data := map[string]interface{}{
+		"generate": generate,
+	}
+	if generate {
+		data["exported"] = d.Get("exported")
+		data["key_size"] = d.Get("key_size")
+		data["skew"] = d.Get("skew")
+		data["qr_size"] = d.Get("qr_size")
+	} else if url != "" {
+		data["url"] = url
+	} else {
+		data["key"] = key
+	}
+	for _, k := range []string{"issuer", "account_name", "period", "algorithm", "digits"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Creating TOTP key %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating TOTP key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created TOTP key %q", path)
+
+	d.SetId(path)
+
+	if resp != nil {
+		if v, ok := resp.Data["url"]; ok {
+			d.Set("url", v)
+		}
+		if v, ok := resp.Data["barcode"]; ok {
+			d.Set("barcode", v)
+		}
+	}
+
+	return totpSecretBackendKeyRead(d, meta)
+}
+
+func totpSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, name, err := totpSecretBackendKeyFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for TOTP key: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading TOTP key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading TOTP key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read TOTP key %q", path)
+	if resp == nil {
+		log.Printf("[WARN] TOTP key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+
+	for _, k := range []string{"issuer", "account_name", "period", "algorithm", "digits"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on TOTP key %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func totpSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting TOTP key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting TOTP key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted TOTP key %q", path)
+
+	return nil
+}
+
+func totpSecretBackendKeyPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
+}
+
+func totpSecretBackendKeyFromPath(path string) (string, string, error) {
+	idx := strings.LastIndex(path, "/keys/")
+	if idx < 1 {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/keys/<name>")
+	}
+
+	name := path[idx+len("/keys/"):]
+	if name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/keys/<name>")
+	}
+
+	return path[:idx], name, nil
+}
@@ -0,0 +1,126 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestTotpSecretBackendKey_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	resourceName := "vault_totp_secret_backend_key.generated"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testTotpSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTotpSecretBackendKeyConfig_basic(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", "generated"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", "Vault"),
+					resource.TestCheckResourceAttr(resourceName, "account_name", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "period", "30"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA1"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "6"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("^otpauth://totp/")),
+					resource.TestCheckResourceAttrSet(resourceName, "barcode"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.imported", "issuer", "Vault"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.imported", "account_name", "test@example.com"),
+					resource.TestCheckResourceAttrSet("data.vault_totp_secret_backend_code.generated", "code"),
+					resource.TestCheckResourceAttr("data.vault_totp_secret_backend_code.validated", "valid", "true"),
+				),
+			},
+			{
+				// The key is deleted out of band and must be created again.
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if _, err := client.Logical().Delete(backend + "/keys/generated"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testTotpSecretBackendKeyConfig_basic(backend),
+				Check:  resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("^otpauth://totp/")),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"generate", "exported", "key_size", "skew", "qr_size", "url", "barcode"},
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "generated" {
+  backend = vault_mount.test.path
+  name    = "generated"
+}
+`, backend),
+				ExpectError: regexp.MustCompile("one of url or key is required when generate is false"),
+			},
+		},
+	})
+}
+
+func testTotpSecretBackendKeyDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_totp_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("TOTP key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testTotpSecretBackendKeyConfig_basic(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "generated" {
+  backend      = vault_mount.test.path
+  name         = "generated"
+  generate     = true
+  issuer       = "Vault"
+  account_name = "test@example.com"
+}
+
+resource "vault_totp_secret_backend_key" "imported" {
+  backend = vault_mount.test.path
+  name    = "imported"
+  url     = vault_totp_secret_backend_key.generated.url
+}
+
+data "vault_totp_secret_backend_code" "generated" {
+  backend = vault_mount.test.path
+  name    = vault_totp_secret_backend_key.imported.name
+}
+
+data "vault_totp_secret_backend_code" "validated" {
+  backend = vault_mount.test.path
+  name    = vault_totp_secret_backend_key.generated.name
+  code    = data.vault_totp_secret_backend_code.generated.code
+}
+`, backend)
+}
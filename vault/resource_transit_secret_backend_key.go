@@ -61,6 +61,12 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				ForceNew:    true,
 				Default:     false,
 			},
+			"auto_rotate_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Amount of time in seconds the key should live before being automatically rotated. A value of 0 disables automatic rotation for the key.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"exportable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -114,10 +120,11 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				},
 			},
 			"min_encryption_version": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Minimum key version to use for encryption",
-				Default:     0,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Minimum key version to use for encryption",
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"supports_encryption": {
 				Type:        schema.TypeBool,
@@ -165,6 +172,9 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				}
 				return nil
 			}),
+			func(d *schema.ResourceDiff, meta interface{}) error {
+				return transitSecretBackendKeyValidateVersions(d.Get("min_decryption_version").(int), d.Get("min_encryption_version").(int))
+			},
 			customdiff.ForceNewIfChange("exportable", func(old, new, meta interface{}) bool {
 				return !new.(bool) && old.(bool)
 			}),
@@ -185,11 +195,14 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 
 	configData := map[string]interface{}{
 		"min_decryption_version": d.Get("min_decryption_version").(int),
-		"min_encryption_versoin": d.Get("min_encryption_version").(int),
+		"min_encryption_version": d.Get("min_encryption_version").(int),
 		"deletion_allowed":       d.Get("deletion_allowed").(bool),
 		"exportable":             d.Get("exportable").(bool),
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
 	}
+	if v, ok := d.GetOk("auto_rotate_period"); ok {
+		configData["auto_rotate_period"] = v.(int)
+	}
 
 	data := map[string]interface{}{
 		"convergent_encryption": d.Get("convergent_encryption").(bool),
@@ -272,6 +285,15 @@ func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("expected min_encryption_version %q to be a number, and it isn't", secret.Data["min_encryption_version"])
 	}
 
+	// auto_rotate_period is only returned by Vault 1.10 and later
+	if v, ok := secret.Data["auto_rotate_period"]; ok {
+		autoRotatePeriod, err := v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("expected auto_rotate_period %q to be a number, and it isn't", v)
+		}
+		d.Set("auto_rotate_period", autoRotatePeriod)
+	}
+
 	ikeys := secret.Data["keys"].(map[string]interface{})
 	keys := []interface{}{}
 	for _, v := range ikeys {
@@ -321,6 +343,9 @@ func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) err
 		"exportable":             d.Get("exportable"),
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup"),
 	}
+	if d.HasChange("auto_rotate_period") {
+		data["auto_rotate_period"] = d.Get("auto_rotate_period")
+	}
 
 	_, err := client.Logical().Write(path+"/config", data)
 	if err != nil {
@@ -357,6 +382,16 @@ func transitSecretBackendKeyExists(d *schema.ResourceData, meta interface{}) (bo
 	return secret != nil, nil
 }
 
+// transitSecretBackendKeyValidateVersions checks the combination of minimum key
+// versions, Vault rejects a min_encryption_version that is lower than the
+// min_decryption_version unless it is 0.
+func transitSecretBackendKeyValidateVersions(minDecryptionVersion, minEncryptionVersion int) error {
+	if minEncryptionVersion != 0 && minEncryptionVersion < minDecryptionVersion {
+		return fmt.Errorf("'min_encryption_version' must be 0 or greater than or equal to 'min_decryption_version' (%d), got: %d", minDecryptionVersion, minEncryptionVersion)
+	}
+	return nil
+}
+
 func transitSecretBackendKeyPath(backend string, name string) string {
 	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
 }
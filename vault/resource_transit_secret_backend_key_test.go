@@ -143,6 +143,36 @@ func TestTransitSecretBackendKey_import(t *testing.T) {
 	})
 }
 
+func TestTransitSecretBackendKey_autoRotate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyConfig_autoRotate(name, backend, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "auto_rotate_period", "3600"),
+				),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_autoRotate(name, backend, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "auto_rotate_period", "7200"),
+				),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_autoRotate(name, backend, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "auto_rotate_period", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testTransitSecretBackendKeyConfig_basic(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
@@ -232,6 +262,22 @@ resource "vault_transit_secret_backend_key" "test" {
 `, path, name)
 }
 
+func testTransitSecretBackendKeyConfig_autoRotate(name, path string, autoRotatePeriod int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend            = "${vault_mount.transit.path}"
+  name               = "%s"
+  deletion_allowed   = true
+  auto_rotate_period = %d
+}
+`, path, name, autoRotatePeriod)
+}
+
 func testTransitSecretBackendKeyCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -249,3 +295,26 @@ func testTransitSecretBackendKeyCheckDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestTransitSecretBackendKeyValidateVersions(t *testing.T) {
+	tests := []struct {
+		minDecryptionVersion int
+		minEncryptionVersion int
+		expectErr            bool
+	}{
+		{minDecryptionVersion: 1, minEncryptionVersion: 0, expectErr: false},
+		{minDecryptionVersion: 1, minEncryptionVersion: 1, expectErr: false},
+		{minDecryptionVersion: 2, minEncryptionVersion: 3, expectErr: false},
+		{minDecryptionVersion: 3, minEncryptionVersion: 2, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		err := transitSecretBackendKeyValidateVersions(tt.minDecryptionVersion, tt.minEncryptionVersion)
+		if tt.expectErr && err == nil {
+			t.Errorf("expected an error for min_decryption_version %d and min_encryption_version %d", tt.minDecryptionVersion, tt.minEncryptionVersion)
+		}
+		if !tt.expectErr && err != nil {
+			t.Errorf("unexpected error for min_decryption_version %d and min_encryption_version %d: %s", tt.minDecryptionVersion, tt.minEncryptionVersion, err)
+		}
+	}
+}
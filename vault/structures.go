@@ -3,6 +3,8 @@ package vault
 import (
 	"encoding/json"
 	"log"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -66,6 +68,58 @@ func flattenAuthMethodTune(dt *api.MountConfigOutput) map[string]interface{} {
 	return m
 }
 
+// mergeAuthMethodTune refreshes the values in current with those from remote,
+// only for the keys that are already set in current. Durations that are
+// equivalent, and lists that only differ in their ordering, keep the value
+// from current so that they do not produce a diff.
+func mergeAuthMethodTune(current, remote map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(current))
+	for k, cv := range current {
+		m[k] = cv
+
+		switch cv := cv.(type) {
+		case string:
+			if cv == "" {
+				continue
+			}
+			rv, _ := remote[k].(string)
+			if rv == cv {
+				continue
+			}
+			cd, cErr := time.ParseDuration(cv)
+			rd, rErr := time.ParseDuration(rv)
+			if cErr == nil && rErr == nil && cd == rd {
+				continue
+			}
+			m[k] = rv
+		case []interface{}:
+			if len(cv) == 0 {
+				continue
+			}
+			rv, _ := remote[k].([]interface{})
+			if sameStringElements(cv, rv) {
+				continue
+			}
+			if rv == nil {
+				rv = []interface{}{}
+			}
+			m[k] = rv
+		}
+	}
+	return m
+}
+
+func sameStringElements(a, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	as := expandStringSlice(a)
+	bs := expandStringSlice(b)
+	sort.Strings(as)
+	sort.Strings(bs)
+	return reflect.DeepEqual(as, bs)
+}
+
 func expandStringSlice(configured []interface{}) []string {
 	vs := make([]string, 0, len(configured))
 	for _, v := range configured {
@@ -69,3 +69,44 @@ func TestFlattenAuthMethodTune(t *testing.T) {
 			expected)
 	}
 }
+
+func TestMergeAuthMethodTune(t *testing.T) {
+	current := map[string]interface{}{
+		"default_lease_ttl":            "3600s",
+		"max_lease_ttl":                "7200s",
+		"audit_non_hmac_request_keys":  []interface{}{"foo", "bar"},
+		"audit_non_hmac_response_keys": []interface{}{"baz"},
+		"listing_visibility":           "",
+		"passthrough_request_headers":  []interface{}{},
+		"allowed_response_headers":     []interface{}{},
+		"token_type":                   "batch",
+	}
+	remote := map[string]interface{}{
+		"default_lease_ttl":           "1h",
+		"max_lease_ttl":               "1h",
+		"audit_non_hmac_request_keys": []interface{}{"bar", "foo"},
+		"listing_visibility":          "unauth",
+		"passthrough_request_headers": []interface{}{"X-Custom"},
+		"token_type":                  "service",
+	}
+
+	expected := map[string]interface{}{
+		"default_lease_ttl":            "3600s",
+		"max_lease_ttl":                "1h",
+		"audit_non_hmac_request_keys":  []interface{}{"foo", "bar"},
+		"audit_non_hmac_response_keys": []interface{}{},
+		"listing_visibility":           "",
+		"passthrough_request_headers":  []interface{}{},
+		"allowed_response_headers":     []interface{}{},
+		"token_type":                   "service",
+	}
+
+	actual := mergeAuthMethodTune(current, remote)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actual,
+			expected)
+	}
+}
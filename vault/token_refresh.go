@@ -0,0 +1,190 @@
+package vault
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+// tokenRefreshTransport retries requests that Vault rejected with a 403 once,
+// with a token obtained from refresh. The provider uses a child token derived
+// from the token of a Vault Agent, which is revoked together with its parent
+// when the agent's token expires, so a long running apply has to be able to
+// derive a new child token half way through.
+type tokenRefreshTransport struct {
+	transport http.RoundTripper
+	// refresh returns the token to retry with, or an empty string if the
+	// request should not be retried.
+	refresh func(staleToken string) (string, error)
+}
+
+func newTokenRefreshTransport(transport http.RoundTripper, refresh func(string) (string, error)) http.RoundTripper {
+	return &tokenRefreshTransport{
+		transport: transport,
+		refresh:   refresh,
+	}
+}
+
+func (t *tokenRefreshTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	staleToken := req.Header.Get(consts.AuthHeaderName)
+	if staleToken == "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	// Keep the body around so that the request can be sent again.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+
+	token, err := t.refresh(staleToken)
+	if err != nil {
+		log.Printf("[WARN] Failed to refresh the Vault token: %s", err)
+		return resp, nil
+	}
+	if token == "" {
+		return resp, nil
+	}
+
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	log.Printf("[INFO] Retrying %s %s with a refreshed Vault token", req.Method, req.URL.Path)
+	retry := req.Clone(req.Context())
+	retry.Header.Set(consts.AuthHeaderName, token)
+	if body != nil {
+		retry.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	return t.transport.RoundTrip(retry)
+}
+
+// tokenRefresher derives a new child token for client from the token file, or
+// from the auto-auth token of the Vault Agent if there is no token file, once
+// the current child token is no longer valid.
+type tokenRefresher struct {
+	sync.Mutex
+
+	// client is the provider's client, it is nil until the provider has
+	// been configured.
+	client *api.Client
+	// headers are the headers configured for the provider's client.
+	headers http.Header
+	// newClient returns a client which does not refresh its token, so that
+	// refreshing cannot recurse.
+	newClient func() (*api.Client, error)
+
+	tokenFile string
+	tokenName string
+	ttl       int
+}
+
+func (r *tokenRefresher) refresh(staleToken string) (string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.client == nil {
+		return "", nil
+	}
+	if current := r.client.Token(); current != staleToken {
+		// another request refreshed the token in the meantime
+		return current, nil
+	}
+
+	c, err := r.newClient()
+	if err != nil {
+		return "", err
+	}
+	if r.headers != nil {
+		c.SetHeaders(r.headers)
+	}
+
+	// A token that is still valid was denied for a reason a new token
+	// will not fix.
+	c.SetToken(staleToken)
+	if _, err := c.Auth().Token().LookupSelf(); err == nil {
+		return "", nil
+	}
+
+	c.ClearToken()
+	if r.tokenFile != "" {
+		token, err := readTokenFile(r.tokenFile)
+		if err != nil {
+			return "", err
+		}
+		c.SetToken(token)
+	}
+
+	log.Printf("[INFO] Vault token is no longer valid, creating a new child token")
+	childTokenLease, err := createChildToken(c, r.tokenName, r.ttl)
+	if err != nil {
+		return "", err
+	}
+
+	token := childTokenLease.Auth.ClientToken
+	r.client.SetToken(token)
+	return token, nil
+}
+
+// readTokenFile reads the token from path, e.g. a Vault Agent file sink.
+func readTokenFile(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading token file %q: %s", path, err)
+	}
+
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %q is empty", path)
+	}
+	return token, nil
+}
+
+// createChildToken creates a limited child token of the token of client that
+// expires after ttl seconds. The namespace of client is set to the namespace
+// of its token.
+func createChildToken(client *api.Client, tokenName string, ttl int) (*api.Secret, error) {
+	// Set the namespace to the token's namespace only for the
+	// child token creation
+	tokenInfo, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return nil, err
+	}
+	if tokenNamespaceRaw, ok := tokenInfo.Data["namespace_path"]; ok {
+		tokenNamespace := tokenNamespaceRaw.(string)
+		if tokenNamespace != "" {
+			client.SetNamespace(tokenNamespace)
+		}
+	}
+
+	renewable := false
+	childTokenLease, err := client.Auth().Token().Create(&api.TokenCreateRequest{
+		DisplayName:    tokenName,
+		TTL:            fmt.Sprintf("%ds", ttl),
+		ExplicitMaxTTL: fmt.Sprintf("%ds", ttl),
+		Renewable:      &renewable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create limited child token: %s", err)
+	}
+
+	return childTokenLease, nil
+}
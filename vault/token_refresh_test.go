@@ -0,0 +1,198 @@
+package vault
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+func TestTokenRefreshTransport(t *testing.T) {
+	tests := []struct {
+		name             string
+		token            string
+		refreshedToken   string
+		expectedStatus   int
+		expectedRequests int
+	}{
+		{
+			name:             "valid-token",
+			token:            "child",
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 1,
+		},
+		{
+			name:             "refreshed",
+			token:            "expired",
+			refreshedToken:   "child",
+			expectedStatus:   http.StatusOK,
+			expectedRequests: 2,
+		},
+		{
+			name:             "not-refreshed",
+			token:            "expired",
+			expectedStatus:   http.StatusForbidden,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				body, _ := ioutil.ReadAll(r.Body)
+				if string(body) != `{"foo":"bar"}` {
+					t.Errorf("unexpected request body %q", body)
+				}
+				if r.Header.Get(consts.AuthHeaderName) != "child" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: newTokenRefreshTransport(http.DefaultTransport, func(staleToken string) (string, error) {
+					if staleToken != tt.token {
+						t.Errorf("expected stale token %q, got %q", tt.token, staleToken)
+					}
+					return tt.refreshedToken, nil
+				}),
+			}
+
+			req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"foo":"bar"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set(consts.AuthHeaderName, tt.token)
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+			}
+			if requests != tt.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tt.expectedRequests, requests)
+			}
+		})
+	}
+}
+
+func TestTokenRefresher(t *testing.T) {
+	created := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get(consts.AuthHeaderName)
+		switch r.URL.Path {
+		case "/v1/auth/token/lookup-self":
+			if token == "expired" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			fmt.Fprint(w, `{"data":{}}`)
+		case "/v1/auth/token/create":
+			if token != "sink" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			created++
+			fmt.Fprintf(w, `{"auth":{"client_token":"child-%d"}}`, created)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "sink")
+	if err := ioutil.WriteFile(tokenFile, []byte("sink\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	newClient := func() (*api.Client, error) {
+		config := api.DefaultConfig()
+		config.Address = server.URL
+		return api.NewClient(config)
+	}
+	client, err := newClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("expired")
+
+	r := &tokenRefresher{
+		client:    client,
+		newClient: newClient,
+		tokenFile: tokenFile,
+		tokenName: "terraform",
+		ttl:       1200,
+	}
+
+	token, err := r.refresh("expired")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "child-1" || client.Token() != "child-1" {
+		t.Fatalf("expected the token to be refreshed to %q, got %q (client %q)", "child-1", token, client.Token())
+	}
+
+	// a request that was sent with the expired token concurrently picks up
+	// the refreshed token without creating another one
+	token, err = r.refresh("expired")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "child-1" {
+		t.Fatalf("expected token %q, got %q", "child-1", token)
+	}
+
+	// a valid token is not refreshed
+	token, err = r.refresh("child-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		t.Fatalf("expected no token, got %q", token)
+	}
+	if created != 1 {
+		t.Fatalf("expected 1 child token to be created, got %d", created)
+	}
+}
+
+func TestReadTokenFile(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "token")
+	if err := ioutil.WriteFile(path, []byte("  s.token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	token, err := readTokenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "s.token" {
+		t.Errorf("expected token %q, got %q", "s.token", token)
+	}
+
+	empty := filepath.Join(dir, "empty")
+	if err := ioutil.WriteFile(empty, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readTokenFile(empty); err == nil {
+		t.Error("expected an error for an empty token file")
+	}
+
+	if _, err := readTokenFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected an error for a missing token file")
+	}
+}
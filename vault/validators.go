@@ -3,10 +3,14 @@ package vault
 import (
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/gosimple/slug"
+	"github.com/hashicorp/terraform-provider-vault/util"
 )
 
 func validateStringSlug(i interface{}, k string) (s []string, es []error) {
@@ -35,6 +39,19 @@ func validateDuration(i interface{}, k string) (s []string, es []error) {
 	return
 }
 
+func validateDurationSecond(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := util.ParseDurationSecond(v); err != nil {
+		es = append(es, fmt.Errorf("expected '%s' to be a valid duration string or number of seconds", k))
+	}
+	return
+}
+
 func validateNoTrailingSlash(i interface{}, k string) (s []string, es []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -47,3 +64,145 @@ func validateNoTrailingSlash(i interface{}, k string) (s []string, es []error) {
 	}
 	return
 }
+
+// usernameTemplateFuncs stubs the functions Vault makes available to
+// username templates, so that templates can be parsed locally.
+var usernameTemplateFuncs = template.FuncMap{
+	"random":           func(int) string { return "" },
+	"truncate":         func(int, string) string { return "" },
+	"truncate_sha256":  func(int, string) string { return "" },
+	"uppercase":        strings.ToUpper,
+	"lowercase":        strings.ToLower,
+	"replace":          func(string, string, string) string { return "" },
+	"sha256":           func(string) string { return "" },
+	"base64":           func(string) string { return "" },
+	"unix_time":        func() string { return "" },
+	"unix_time_millis": func() string { return "" },
+	"timestamp":        func(string) string { return "" },
+	"uuid":             func() string { return "" },
+}
+
+func validateUsernameTemplate(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := template.New(k).Funcs(usernameTemplateFuncs).Parse(v); err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a valid username template: %s", k, err))
+	}
+	return
+}
+
+// cronScheduleFields describes the fields of a standard cron expression, in
+// order, with their bounds and the names they accept.
+var cronScheduleFields = []struct {
+	name     string
+	min, max int
+	names    []string
+}{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 6, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+var cronScheduleDescriptors = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+
+// validateCronSchedule validates a standard five field cron expression, as
+// accepted by Vault for rotation schedules.
+func validateCronSchedule(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, descriptor := range cronScheduleDescriptors {
+		if v == descriptor {
+			return
+		}
+	}
+
+	fields := strings.Fields(v)
+	if len(fields) != len(cronScheduleFields) {
+		es = append(es, fmt.Errorf("expected %s to be a cron expression with %d fields, got %q", k, len(cronScheduleFields), v))
+		return
+	}
+
+	for idx, field := range fields {
+		spec := cronScheduleFields[idx]
+		for _, item := range strings.Split(field, ",") {
+			if err := validateCronScheduleItem(item, spec.min, spec.max, spec.names); err != nil {
+				es = append(es, fmt.Errorf("invalid %s field %q in %s: %s", spec.name, field, k, err))
+			}
+		}
+	}
+	return
+}
+
+func validateCronScheduleItem(item string, min, max int, names []string) error {
+	value := func(raw string) (int, error) {
+		for idx, name := range names {
+			if strings.EqualFold(raw, name) {
+				return min + idx, nil
+			}
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number", raw)
+		}
+		if n < min || n > max {
+			return 0, fmt.Errorf("%d is out of range [%d, %d]", n, min, max)
+		}
+		return n, nil
+	}
+
+	rangePart := item
+	if idx := strings.Index(item, "/"); idx != -1 {
+		rangePart = item[:idx]
+		step, err := strconv.Atoi(item[idx+1:])
+		if err != nil || step <= 0 {
+			return fmt.Errorf("invalid step in %q", item)
+		}
+	}
+
+	if rangePart == "*" || rangePart == "?" {
+		return nil
+	}
+
+	bounds := strings.SplitN(rangePart, "-", 2)
+	start, err := value(bounds[0])
+	if err != nil {
+		return err
+	}
+	if len(bounds) == 2 {
+		end, err := value(bounds[1])
+		if err != nil {
+			return err
+		}
+		if end < start {
+			return fmt.Errorf("invalid range %q", rangePart)
+		}
+	}
+
+	return nil
+}
+
+func validateCIDROrIP(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(v); err == nil {
+		return
+	}
+	if net.ParseIP(v) == nil {
+		es = append(es, fmt.Errorf("expected %s to be a valid CIDR block or IP address, got: %s", k, v))
+	}
+	return
+}
@@ -53,3 +53,65 @@ func TestValidateNoTrailingSlash(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDurationSecond(t *testing.T) {
+	for _, v := range []string{"30s", "45m", "1h30m", "30", "0"} {
+		if _, errs := validateDurationSecond(v, "not_before_duration"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"", "30x", "thirty"} {
+		if _, errs := validateDurationSecond(v, "not_before_duration"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateCIDROrIP(t *testing.T) {
+	for _, v := range []string{"10.0.0.0/8", "192.168.1.1", "192.168.1.1/32", "2001:db8::/32", "::1"} {
+		if _, errs := validateCIDROrIP(v, "token_bound_cidrs"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"", "10.0.0.0/33", "not-an-ip", "192.168.1"} {
+		if _, errs := validateCIDROrIP(v, "token_bound_cidrs"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateUsernameTemplate(t *testing.T) {
+	for _, v := range []string{
+		"",
+		"static-username",
+		"{{ .DisplayName }}-{{ random 8 }}",
+		`{{ printf "v-%s-%s" (.RoleName | truncate 10) (random 20) | truncate 32 | lowercase }}`,
+		`{{ unix_time }}-{{ uuid }}-{{ timestamp "2006" }}`,
+	} {
+		if _, errs := validateUsernameTemplate(v, "username_template"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"{{ .DisplayName", "{{ unknown_func 8 }}", "{{ end }}"} {
+		if _, errs := validateUsernameTemplate(v, "username_template"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateCronSchedule(t *testing.T) {
+	for _, v := range []string{"0 2 * * *", "*/15 * * * *", "0 0 1,15 * MON-FRI", "30 3 * jan-mar sun", "0 0-6/2 * * 1-5", "@daily"} {
+		if _, errs := validateCronSchedule(v, "rotation_schedule"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+
+	for _, v := range []string{"", "0 2 * *", "0 2 * * * *", "60 * * * *", "0 24 * * *", "0 0 0 * *", "0 0 * 13 *", "0 0 * * 7", "*/0 * * * *", "5-1 * * * *", "@sometimes", "a b c d e"} {
+		if _, errs := validateCronSchedule(v, "rotation_schedule"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}